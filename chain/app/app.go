@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
 	"github.com/gorilla/mux"
@@ -26,6 +27,7 @@ import (
 	minterclient "github.com/MinterTeam/mhub/chain/x/minter/client"
 	minterkeeper "github.com/MinterTeam/mhub/chain/x/minter/keeper"
 	mintertypes "github.com/MinterTeam/mhub/chain/x/minter/types"
+	oracleclient "github.com/MinterTeam/mhub/chain/x/oracle/client"
 	oraclekeeper "github.com/MinterTeam/mhub/chain/x/oracle/keeper"
 	oracletypes "github.com/MinterTeam/mhub/chain/x/oracle/types"
 	"github.com/MinterTeam/mhub/chain/x/peggy"
@@ -126,6 +128,7 @@ var (
 			upgradeclient.CancelProposalHandler,
 			minterclient.ProposalHandler,
 			peggyclient.ProposalHandler,
+			oracleclient.ProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -411,6 +414,7 @@ func NewMhubApp(
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
 		AddRoute(peggytypes.RouterKey, peggy.NewColdStorageTransferProposalHandler(app.peggyKeeper)).
 		AddRoute(mintertypes.RouterKey, minter.NewColdStorageTransferProposalHandler(app.minterKeeper)).
+		AddRoute(oracletypes.RouterKey, oracle.NewClearPriceHaltProposalHandler(app.oracleKeeper)).
 		AddRoute(ibchost.RouterKey, ibcclient.NewClientUpdateProposalHandler(app.ibcKeeper.ClientKeeper))
 
 	app.govKeeper = govkeeper.NewKeeper(
@@ -530,14 +534,16 @@ func NewMhubApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		crisistypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		// the bridge modules need the coins of the oracle
+		oracletypes.ModuleName,
 		peggytypes.ModuleName,
 		mintertypes.ModuleName,
-		oracletypes.ModuleName,
+		// crisis runs the invariants, so it goes last to check the whole state
+		crisistypes.ModuleName,
 	)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
@@ -599,6 +605,18 @@ func NewMhubApp(
 	app.upgradeKeeper.SetUpgradeHandler("v0.0.2", func(ctx sdk.Context, plan upgradetypes.Plan) {})
 	app.upgradeKeeper.SetUpgradeHandler("v0.0.3", func(ctx sdk.Context, plan upgradetypes.Plan) {})
 	app.upgradeKeeper.SetUpgradeHandler("v0.0.4", func(ctx sdk.Context, plan upgradetypes.Plan) {})
+	app.upgradeKeeper.SetUpgradeHandler("v0.0.5", func(ctx sdk.Context, plan upgradetypes.Plan) {
+		app.oracleKeeper.MigrateParams(ctx)
+		app.minterKeeper.MigrateParams(ctx)
+		if strings.HasPrefix(ctx.ChainID(), "mhub-test") {
+			app.minterKeeper.SetMinterChainID(ctx, mintertypes.MinterTestnetChainID)
+		}
+		app.minterKeeper.MigrateVoucherAccounting(ctx)
+		app.peggyKeeper.MigrateVoucherAccounting(ctx)
+		app.minterKeeper.MigratePoolIndexes(ctx)
+		app.peggyKeeper.MigratePoolIndexes(ctx)
+		app.minterKeeper.MigrateAttestationKeys(ctx)
+	})
 
 	return app
 }
@@ -0,0 +1,30 @@
+package minter
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	hasPendingValset := false
+	k.IterateValsetRequest(ctx, func(_ []byte, _ *types.Valset) bool {
+		hasPendingValset = true
+		return true
+	})
+
+	if !hasPendingValset {
+		lastValset := k.GetLastValset(ctx)
+		if lastValset == nil || types.BridgeValidators(k.GetCurrentValset(ctx).Members).PowerDiff(lastValset.Members) > 0.01 {
+			k.SetValsetRequest(ctx)
+		}
+	}
+
+	// give the txs of the batches which were not executed in time another chance
+	k.CancelTimedOutBatches(ctx)
+
+	// punish the validators which did not sign the valsets and batches in time
+	k.SlashUnsignedValsets(ctx)
+	k.SlashUnsignedBatches(ctx)
+}
@@ -0,0 +1,121 @@
+package minter
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelTimedOutBatches(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+
+	entry := func(id uint64, amount int64) types.OutgoingTxEntry {
+		return types.OutgoingTxEntry{Id: id, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("usdc", amount)}}
+	}
+	transfer := func(id uint64, amount int64) *types.OutgoingTransferTx {
+		return &types.OutgoingTransferTx{Id: id, DestAddress: receiver, MinterToken: types.NewMinterCoin(sdk.NewInt(amount), 1833)}
+	}
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	height := uint64(ctx.BlockHeight())
+
+	params := types.DefaultParams()
+	params.BatchTimeoutBlocks = 100
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params:           params,
+		StartMinterNonce: 12,
+		OutgoingTxs:      []types.OutgoingTxEntry{entry(1, 10), entry(2, 20), entry(4, 5)},
+		UnbatchedTxIds:   []uint64{2},
+		Batches: []*types.OutgoingTxBatch{
+			{BatchNonce: 3, MinterNonce: 10, Block: height - 100, Transactions: []*types.OutgoingTransferTx{transfer(1, 10)}},
+			{BatchNonce: 4, MinterNonce: 11, Block: height - 99, Transactions: []*types.OutgoingTransferTx{transfer(4, 5)}},
+		},
+		StartBatchNonce: 5,
+		VoucherAccounting: types.VoucherAccounting{
+			Burned: sdk.NewCoins(sdk.NewInt64Coin("usdc", 35)),
+		},
+	})
+
+	k.CancelTimedOutBatches(ctx)
+
+	// the timed out batch is replaced by one with the same Minter nonce, the other batch is not timed out yet
+	assert.Nil(t, k.GetOutgoingTXBatch(ctx, 3))
+	require.NotNil(t, k.GetOutgoingTXBatch(ctx, 4))
+
+	replacement := k.GetOutgoingTXBatch(ctx, 5)
+	require.NotNil(t, replacement)
+	assert.Equal(t, uint64(10), replacement.MinterNonce)
+	assert.Equal(t, height, replacement.Block)
+	assert.Equal(t, []*types.OutgoingTransferTx{transfer(1, 10)}, replacement.Transactions)
+	assert.Equal(t, []uint64{2}, poolIDs(ctx, k))
+	assert.Equal(t, []types.TimedOutBatch{{BatchNonce: 3, MinterNonce: 10}}, k.GetTimedOutBatches(ctx))
+	assert.Equal(t, uint64(12), keeper.ExportGenesis(ctx, k).StartMinterNonce)
+
+	var timeouts int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeOutgoingBatchTimeout {
+			timeouts++
+		}
+	}
+	assert.Equal(t, 1, timeouts)
+
+	res, broken := keeper.AllInvariants(k)(ctx)
+	assert.False(t, broken, res)
+
+	// the execution of the replacement does not cancel the batch with the later Minter nonce
+	require.NoError(t, k.OutgoingTxBatchExecuted(ctx, 5, "0xaa"))
+	assert.NotNil(t, k.GetOutgoingTXBatch(ctx, 4))
+	assert.Empty(t, k.GetTimedOutBatches(ctx))
+
+	res, broken = keeper.AllInvariants(k)(ctx)
+	assert.False(t, broken, res)
+}
+
+func TestTimedOutBatchExecutedLate(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	height := uint64(ctx.BlockHeight())
+
+	params := types.DefaultParams()
+	params.BatchTimeoutBlocks = 100
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params:           params,
+		StartMinterNonce: 11,
+		OutgoingTxs: []types.OutgoingTxEntry{
+			{Id: 1, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("usdc", 10)}},
+			{Id: 2, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("usdc", 20)}},
+		},
+		UnbatchedTxIds: []uint64{2},
+		Batches: []*types.OutgoingTxBatch{{
+			BatchNonce:   3,
+			MinterNonce:  10,
+			Block:        height - 100,
+			Transactions: []*types.OutgoingTransferTx{{Id: 1, DestAddress: receiver, MinterToken: types.NewMinterCoin(sdk.NewInt(10), 1833)}},
+		}},
+		StartBatchNonce: 4,
+		VoucherAccounting: types.VoucherAccounting{
+			Burned: sdk.NewCoins(sdk.NewInt64Coin("usdc", 30)),
+		},
+	})
+
+	k.CancelTimedOutBatches(ctx)
+	require.NotNil(t, k.GetOutgoingTXBatch(ctx, 4))
+
+	// the timed out batch was executed on Minter, its claim executes the replacement so the txs are not paid twice
+	require.NoError(t, k.OutgoingTxBatchExecuted(ctx, 3, "0xaa"))
+	assert.Nil(t, k.GetOutgoingTXBatch(ctx, 4))
+	assert.Equal(t, []uint64{2}, poolIDs(ctx, k))
+	assert.Empty(t, k.GetTimedOutBatches(ctx))
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("usdc", 10)), k.GetVoucherAccounting(ctx).Released)
+
+	// the tombstone is gone once the Minter nonce is used up
+	assert.Error(t, k.OutgoingTxBatchExecuted(ctx, 3, "0xaa"))
+
+	res, broken := keeper.AllInvariants(k)(ctx)
+	assert.False(t, broken, res)
+}
@@ -0,0 +1,140 @@
+package minter
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+func TestGenesisRoundTrip(t *testing.T) {
+	var (
+		validatorAcc sdk.AccAddress = make([]byte, sdk.AddrLen)
+		validatorVal                = sdk.ValAddress(validatorAcc)
+		minterAddr                  = "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56"
+		otherMinter                 = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+	)
+	otherAcc := sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 1))
+
+	members := []*types.BridgeValidator{{Power: 100, MinterAddress: minterAddr}}
+	transfer := &types.OutgoingTransferTx{
+		Id:          2,
+		Sender:      validatorAcc.String(),
+		DestAddress: otherMinter,
+		MinterToken: types.NewMinterCoin(sdk.NewInt(1000), 1),
+		TxHash:      "0x01",
+	}
+
+	params := types.DefaultParams()
+	params.BridgeChainId = 1
+	params.MinterAddress = minterAddr
+
+	src := types.GenesisState{
+		Params:           params,
+		StartMinterNonce: 12,
+		Valsets:          []*types.Valset{{Nonce: 20, MinterNonce: 11, Members: members}},
+		ValsetConfirms: []*types.MsgValsetConfirm{
+			{Nonce: 20, Validator: validatorAcc.String(), MinterAddress: minterAddr, Signature: "0xaa"},
+		},
+		LastValset: &types.Valset{Nonce: 10, MinterNonce: 9, Members: members},
+		OutgoingTxs: []types.OutgoingTxEntry{
+			{Id: 1, Tx: types.OutgoingTx{Sender: validatorAcc.String(), DestAddr: otherMinter, Amount: sdk.NewInt64Coin("hub", 10), TxHash: "0x00"}},
+			{Id: 2, Tx: types.OutgoingTx{Sender: validatorAcc.String(), DestAddr: otherMinter, Amount: sdk.NewInt64Coin("hub", 1000), TxHash: "0x01"}},
+		},
+		UnbatchedTxIds: []uint64{1},
+		Batches:        []*types.OutgoingTxBatch{{BatchNonce: 3, MinterNonce: 10, Transactions: []*types.OutgoingTransferTx{transfer}}},
+		BatchConfirms: []*types.MsgConfirmBatch{
+			{Nonce: 3, MinterSigner: minterAddr, Validator: validatorAcc.String(), Signature: "0xbb"},
+		},
+		Attestations: []types.AttestationEntry{
+			{ClaimHash: tmhash.Sum([]byte("claim")), Attestation: types.Attestation{EventNonce: 5, Observed: true, Votes: []string{validatorVal.String()}}},
+		},
+		LastObservedEventNonce: 5,
+		LastEventNonces:        []types.LastEventNonce{{Validator: validatorVal.String(), Nonce: 5}},
+		MinterAddresses: []types.MinterAddress{
+			{Validator: validatorAcc.String(), MinterAddress: minterAddr},
+			{Validator: otherAcc.String(), MinterAddress: otherMinter},
+		},
+		StartTxPoolId:       3,
+		StartBatchNonce:     4,
+		ValsetSigningChecks: []types.SigningCheck{{Nonce: 20, Height: 20, Validators: []string{validatorVal.String()}}},
+		BatchSigningChecks:  []types.SigningCheck{{Nonce: 3, Height: 15, Validators: []string{validatorVal.String()}}},
+		VoucherAccounting: types.VoucherAccounting{
+			Minted: sdk.NewCoins(sdk.NewInt64Coin("hub", 5000)),
+			Burned: sdk.NewCoins(sdk.NewInt64Coin("hub", 1010)),
+		},
+		TimedOutBatches: []types.TimedOutBatch{{BatchNonce: 2, MinterNonce: 10}},
+		Claims: []types.ClaimEntry{
+			{ClaimType: types.CLAIM_TYPE_DEPOSIT, EventNonce: 5, Validator: validatorVal.String(), ClaimHash: tmhash.Sum([]byte("claim"))},
+		},
+	}
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	keeper.InitGenesis(ctx, k, src)
+	exported := keeper.ExportGenesis(ctx, k)
+	assert.Equal(t, src, exported)
+
+	// the exported state restores the same state in a new chain
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	bz, err := cdc.MarshalJSON(&exported)
+	require.NoError(t, err)
+
+	var imported types.GenesisState
+	require.NoError(t, cdc.UnmarshalJSON(bz, &imported))
+
+	k, ctx, _ = keeper.CreateTestEnv(t)
+	keeper.InitGenesis(ctx, k, imported)
+	reexported := keeper.ExportGenesis(ctx, k)
+
+	reexportedBz, err := cdc.MarshalJSON(&reexported)
+	require.NoError(t, err)
+	assert.Equal(t, string(bz), string(reexportedBz))
+
+	// the restored state is usable by the keeper
+	assert.Equal(t, minterAddr, k.GetMinterAddress(ctx, validatorAcc))
+	assert.NotNil(t, k.GetValsetConfirm(ctx, 20, validatorAcc))
+	assert.NotNil(t, k.GetBatchConfirm(ctx, 3, validatorAcc))
+	assert.Equal(t, uint64(5), k.GetLastEventNonceByValidator(ctx, validatorVal))
+	assert.Equal(t, []uint64{1}, poolIDs(ctx, k))
+}
+
+func TestGenesisWithoutVoucherAccounting(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+	k, ctx, _ := keeper.CreateTestEnv(t)
+
+	// a genesis exported before the vouchers were accounted
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params: types.DefaultParams(),
+		OutgoingTxs: []types.OutgoingTxEntry{
+			{Id: 2, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("hub", 10)}},
+			{Id: 3, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("hub", 20)}},
+		},
+		UnbatchedTxIds: []uint64{3},
+		Batches: []*types.OutgoingTxBatch{{
+			BatchNonce:   1,
+			MinterNonce:  1,
+			Transactions: []*types.OutgoingTransferTx{{Id: 2, DestAddress: receiver}},
+		}},
+	})
+
+	res, broken := keeper.AllInvariants(k)(ctx)
+	assert.False(t, broken, res)
+
+	pending := sdk.NewCoins(sdk.NewInt64Coin("hub", 30))
+	assert.Equal(t, types.VoucherAccounting{Minted: pending, Burned: pending}, k.GetVoucherAccounting(ctx))
+}
+
+func poolIDs(ctx sdk.Context, k keeper.Keeper) []uint64 {
+	var ids []uint64
+	k.IterateOutgoingPool(ctx, func(id uint64, _ *types.OutgoingTx) bool {
+		ids = append(ids, id)
+		return false
+	})
+	return ids
+}
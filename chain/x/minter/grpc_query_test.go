@@ -0,0 +1,112 @@
+package minter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingTransfersQueries(t *testing.T) {
+	var (
+		sender    = sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+		other     = sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 1)).String()
+		receiver  = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+		receiver2 = "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56"
+	)
+
+	entry := func(id uint64, sender, receiver, txHash string) types.OutgoingTxEntry {
+		return types.OutgoingTxEntry{Id: id, Tx: types.OutgoingTx{Sender: sender, DestAddr: receiver, Amount: sdk.NewInt64Coin("hub", 10), TxHash: txHash}}
+	}
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params: types.DefaultParams(),
+		OutgoingTxs: []types.OutgoingTxEntry{
+			entry(1, sender, receiver, "0xaa"),
+			entry(2, sender, receiver2, "0xbb"),
+			entry(3, other, receiver, "0xcc"),
+		},
+		UnbatchedTxIds: []uint64{2, 3},
+		Batches: []*types.OutgoingTxBatch{{
+			BatchNonce:   7,
+			MinterNonce:  1,
+			Transactions: []*types.OutgoingTransferTx{{Id: 1, Sender: sender, DestAddress: receiver, TxHash: "0xaa"}},
+		}},
+	})
+	c := sdk.WrapSDKContext(ctx)
+
+	bySender, err := k.PendingTransfersBySender(c, &types.QueryPendingTransfersBySenderRequest{Sender: sender})
+	require.NoError(t, err)
+	require.Len(t, bySender.Transfers, 2)
+	assert.Equal(t, uint64(1), bySender.Transfers[0].Id)
+	assert.Equal(t, uint64(7), bySender.Transfers[0].BatchNonce)
+	assert.Equal(t, uint64(2), bySender.Transfers[1].Id)
+	assert.Equal(t, uint64(0), bySender.Transfers[1].BatchNonce)
+
+	// the receiver is matched case insensitively and the results are paginated
+	byReceiver, err := k.PendingTransfersByReceiver(c, &types.QueryPendingTransfersByReceiverRequest{
+		Receiver:   "Mx7F0FC21D932F38CA9444F61703174569066CFA50",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Transfers, 1)
+	assert.Equal(t, uint64(1), byReceiver.Transfers[0].Id)
+	assert.Equal(t, uint64(2), byReceiver.Pagination.Total)
+
+	byReceiver, err = k.PendingTransfersByReceiver(c, &types.QueryPendingTransfersByReceiverRequest{
+		Receiver:   receiver,
+		Pagination: &query.PageRequest{Key: byReceiver.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Transfers, 1)
+	assert.Equal(t, uint64(3), byReceiver.Transfers[0].Id)
+
+	byHash, err := k.TransferByTxHash(c, &types.QueryTransferByTxHashRequest{TxHash: "0xcc"})
+	require.NoError(t, err)
+	require.Len(t, byHash.Transfers, 1)
+	assert.Equal(t, other, byHash.Transfers[0].Tx.Sender)
+
+	// the executed transfers leave the indexes
+	require.NoError(t, k.OutgoingTxBatchExecuted(ctx, 7, "0xdd"))
+	bySender, err = k.PendingTransfersBySender(c, &types.QueryPendingTransfersBySenderRequest{Sender: sender})
+	require.NoError(t, err)
+	require.Len(t, bySender.Transfers, 1)
+	assert.Equal(t, uint64(2), bySender.Transfers[0].Id)
+
+	byHash, err = k.TransferByTxHash(c, &types.QueryTransferByTxHashRequest{TxHash: "0xaa"})
+	require.NoError(t, err)
+	assert.Empty(t, byHash.Transfers)
+
+	_, err = k.PendingTransfersBySender(c, &types.QueryPendingTransfersBySenderRequest{})
+	assert.Error(t, err)
+}
+
+func TestPendingTransfersLongIndexValue(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+	// the length of the long hash does not fit a byte, it must not be mistaken for the short one
+	longHash := strings.Repeat("a", 257)
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params: types.DefaultParams(),
+		OutgoingTxs: []types.OutgoingTxEntry{
+			{Id: 1, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("hub", 10), TxHash: "a"}},
+			{Id: 2, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("hub", 10), TxHash: longHash}},
+		},
+		UnbatchedTxIds: []uint64{1, 2},
+	})
+	c := sdk.WrapSDKContext(ctx)
+
+	for hash, expID := range map[string]uint64{"a": 1, longHash: 2} {
+		byHash, err := k.TransferByTxHash(c, &types.QueryTransferByTxHashRequest{TxHash: hash})
+		require.NoError(t, err)
+		require.Len(t, byHash.Transfers, 1)
+		assert.Equal(t, expID, byHash.Transfers[0].Id)
+	}
+}
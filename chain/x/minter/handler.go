@@ -2,13 +2,15 @@ package minter
 
 import (
 	"bytes"
+	"encoding/hex"
 	"fmt"
-	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"strings"
 
 	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
 	"github.com/MinterTeam/mhub/chain/x/minter/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns a handler for "Peggy" type messages.
@@ -179,29 +181,14 @@ func handleMsgConfirmBatch(ctx sdk.Context, keeper keeper.Keeper, msg *types.Msg
 
 	valaddr, _ := sdk.AccAddressFromBech32(msg.Validator)
 
-	//peggyID := keeper.GetPeggyID(ctx)
-	//checkpoint, err := batch.GetCheckpoint(peggyID)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(types.ErrInvalid, "checkpoint generation")
-	//}
-	//
-	//sigBytes, err := hex.DecodeString(msg.Signature)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
-	//}
-	//validator := findValidatorKey(ctx, valaddr)
-	//if validator == nil {
-	//	return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
-	//}
-	//
-	//ethAddress := keeper.GetMinterAddress(ctx, sdk.AccAddress(validator))
-	//if ethAddress == "" {
-	//	return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
-	//}
-	//err = types.ValidateMinterSignature(checkpoint, sigBytes, ethAddress)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with peggy-id %s with checkpoint %s found %s", ethAddress, peggyID, hex.EncodeToString(checkpoint), msg.Signature))
-	//}
+	signingData, err := batch.GetMinterSigningData(keeper.GetMinterChainID(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "minter tx generation")
+	}
+
+	if err := validateMinterConfirm(ctx, keeper, valaddr, msg.MinterSigner, signingData, msg.Signature); err != nil {
+		return nil, err
+	}
 
 	// check if we already have this confirm
 	if keeper.GetBatchConfirm(ctx, msg.Nonce, valaddr) != nil {
@@ -224,27 +211,14 @@ func handleMsgConfirmValset(ctx sdk.Context, keeper keeper.Keeper, msg *types.Ms
 
 	valaddr, _ := sdk.AccAddressFromBech32(msg.Validator)
 
-	//peggyID := keeper.GetPeggyID(ctx)
-	//checkpoint := valset.GetCheckpoint(peggyID)
-	//
-	//sigBytes, err := hex.DecodeString(msg.Signature)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(types.ErrInvalid, "signature decoding")
-	//}
-	//
-	//validator := findValidatorKey(ctx, valaddr)
-	//if validator == nil {
-	//	return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
-	//}
-	//
-	//ethAddress := keeper.GetMinterAddress(ctx, sdk.AccAddress(validator))
-	//if ethAddress == "" {
-	//	return nil, sdkerrors.Wrap(types.ErrEmpty, "eth address")
-	//}
-	//err = types.ValidateMinterSignature(checkpoint, sigBytes, ethAddress)
-	//if err != nil {
-	//	return nil, sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with peggy-id %s with checkpoint %s found %s", ethAddress, peggyID, hex.EncodeToString(checkpoint), msg.Signature))
-	//}
+	signingData, err := valset.GetMinterSigningData(keeper.GetMinterChainID(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "minter tx generation")
+	}
+
+	if err := validateMinterConfirm(ctx, keeper, valaddr, msg.MinterAddress, signingData, msg.Signature); err != nil {
+		return nil, err
+	}
 
 	// persist signature
 	if keeper.GetValsetConfirm(ctx, msg.Nonce, valaddr) != nil {
@@ -256,6 +230,35 @@ func handleMsgConfirmValset(ctx sdk.Context, keeper keeper.Keeper, msg *types.Ms
 	}, nil
 }
 
+// validateMinterConfirm checks that the signature over the Minter transaction was made by the Minter address
+// registered for the validator and that the confirm declares that address as its signer
+func validateMinterConfirm(ctx sdk.Context, keeper keeper.Keeper, valaddr sdk.AccAddress, signer string, signingData []byte, signature string) error {
+	sigBytes, err := types.DecodeMinterSignature(signature)
+	if err != nil {
+		return err
+	}
+
+	validator := findValidatorKey(ctx, valaddr)
+	if validator == nil {
+		return sdkerrors.Wrap(types.ErrUnknown, "validator")
+	}
+
+	minterAddress := keeper.GetMinterAddress(ctx, sdk.AccAddress(validator))
+	if minterAddress == "" {
+		return sdkerrors.Wrap(types.ErrEmpty, "minter address")
+	}
+
+	if !strings.EqualFold(signer, minterAddress) {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signer %s is not the registered minter address %s", signer, minterAddress))
+	}
+
+	if err := types.ValidateMinterSignature(signingData, sigBytes, minterAddress); err != nil {
+		return sdkerrors.Wrap(types.ErrInvalid, fmt.Sprintf("signature verification failed expected sig by %s with minter tx %s found %s", minterAddress, hex.EncodeToString(signingData), signature))
+	}
+
+	return nil
+}
+
 func handleMsgSetMinterAddress(ctx sdk.Context, keeper keeper.Keeper, msg *types.MsgSetMinterAddress) (*sdk.Result, error) {
 	valaddr, _ := sdk.AccAddressFromBech32(msg.Validator)
 	validator := findValidatorKey(ctx, valaddr)
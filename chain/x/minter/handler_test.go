@@ -279,3 +279,77 @@ func TestHandleCreateEthereumClaimsMultiValidator(t *testing.T) {
 	balance3 := keepers.BankKeeper.GetAllBalances(ctx, myCosmosAddr)
 	assert.Equal(t, sdk.Coins{sdk.NewInt64Coin("minter/0", 12)}, balance3)
 }
+
+func TestHandleMsgConfirmBatchSigner(t *testing.T) {
+	const (
+		minterAddr     = "Mx31e61a05adbd13c6b625262704bc305bf7725026"
+		otherMinter    = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+		batchSignature = "f8431ba0ded92733bd083039ab79531ababf3cdc88f703c6d2941ad76739d41a70da1df0a066cffb04f4ca1662f161b9b0cbb21c3b18c96d6aa49e1026ed3e6a6699240bd5"
+	)
+	var orchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	params := types.DefaultParams()
+	// the ETH chain id is 0 in the mainnet and testnet genesis, the confirms are signed for the Minter chain id
+	params.BridgeChainId = 0
+	params.MinterChainId = types.MinterTestnetChainID
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params:          params,
+		MinterAddresses: []types.MinterAddress{{Validator: orchestratorAddr.String(), MinterAddress: minterAddr}},
+		Batches: []*types.OutgoingTxBatch{{
+			BatchNonce:  7,
+			MinterNonce: 42,
+			Transactions: []*types.OutgoingTransferTx{
+				{Id: 1, DestAddress: "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56", MinterToken: types.NewMinterCoin(sdk.NewInt(1000), 1)},
+				{Id: 2, DestAddress: otherMinter, MinterToken: types.NewMinterCoin(sdk.NewInt(25).Mul(sdk.NewInt(1e18)), 1833)},
+			},
+		}},
+	})
+	h := NewHandler(k)
+
+	// the signature is valid, but the confirm declares another signer than the registered one
+	_, err := h(ctx, &types.MsgConfirmBatch{Nonce: 7, MinterSigner: otherMinter, Validator: orchestratorAddr.String(), Signature: batchSignature})
+	require.Error(t, err)
+	assert.Nil(t, k.GetBatchConfirm(ctx, 7, orchestratorAddr))
+
+	_, err = h(ctx, &types.MsgConfirmBatch{Nonce: 7, MinterSigner: minterAddr, Validator: orchestratorAddr.String(), Signature: batchSignature})
+	require.NoError(t, err)
+	assert.NotNil(t, k.GetBatchConfirm(ctx, 7, orchestratorAddr))
+}
+
+func TestHandleSendToEthClaimWithoutPrice(t *testing.T) {
+	var (
+		orchestratorAddr sdk.AccAddress = make([]byte, sdk.AddrLen)
+		minterSender                    = "Mxf9613b532673Cc223aBa451dFA8539B87e1F666D"
+	)
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	k.StakingKeeper = keeper.NewStakingKeeperMock(sdk.ValAddress(orchestratorAddr))
+	h := NewHandler(k)
+
+	// the oracle has no price of the coin yet, the fee can not be checked
+	_, err := h(ctx, &types.MsgSendToEthClaim{
+		EventNonce:   1,
+		CoinId:       1833,
+		Amount:       sdk.NewInt(1000),
+		Fee:          sdk.NewInt(10),
+		MinterSender: minterSender,
+		EthReceiver:  "0x3c9289da00b02dC623d0D8D907619890301D26d4",
+		Orchestrator: orchestratorAddr.String(),
+		TxHash:       "0xaa",
+	})
+	require.NoError(t, err)
+
+	// the deposit is refunded instead of being stuck in a failed claim
+	pool := keeper.ExportGenesis(ctx, k).OutgoingTxs
+	require.Len(t, pool, 1)
+	assert.Equal(t, minterSender, pool[0].Tx.DestAddr)
+	assert.Equal(t, "usdc", pool[0].Tx.Amount.Denom)
+
+	var refunds int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRefund {
+			refunds++
+		}
+	}
+	assert.Equal(t, 1, refunds)
+}
@@ -0,0 +1,102 @@
+package minter
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInvariants(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+
+	entry := func(id uint64, amount int64) types.OutgoingTxEntry {
+		return types.OutgoingTxEntry{Id: id, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("hub", amount)}}
+	}
+	batch := func(nonce uint64, ids ...uint64) *types.OutgoingTxBatch {
+		b := &types.OutgoingTxBatch{BatchNonce: nonce, MinterNonce: nonce}
+		for _, id := range ids {
+			b.Transactions = append(b.Transactions, &types.OutgoingTransferTx{Id: id, DestAddress: receiver})
+		}
+		return b
+	}
+	accounting := func(burned, released int64) types.VoucherAccounting {
+		return types.VoucherAccounting{
+			Burned:   sdk.NewCoins(sdk.NewInt64Coin("hub", burned)),
+			Released: sdk.NewCoins(sdk.NewInt64Coin("hub", released)),
+		}
+	}
+
+	specs := map[string]struct {
+		state     types.GenesisState
+		expBroken string
+	}{
+		"consistent": {
+			state: types.GenesisState{
+				OutgoingTxs:       []types.OutgoingTxEntry{entry(2, 10), entry(3, 20)},
+				UnbatchedTxIds:    []uint64{3},
+				Batches:           []*types.OutgoingTxBatch{batch(1, 2)},
+				VoucherAccounting: accounting(100, 70),
+			},
+		},
+		"pool does not match burned vouchers": {
+			state: types.GenesisState{
+				OutgoingTxs:       []types.OutgoingTxEntry{entry(2, 10)},
+				UnbatchedTxIds:    []uint64{2},
+				VoucherAccounting: accounting(100, 70),
+			},
+			expBroken: "outgoing-pool",
+		},
+		"tx is neither unbatched nor batched": {
+			state: types.GenesisState{
+				OutgoingTxs:       []types.OutgoingTxEntry{entry(2, 10)},
+				VoucherAccounting: accounting(10, 0),
+			},
+			expBroken: "outgoing-pool",
+		},
+		"unbatched id without pool entry": {
+			state: types.GenesisState{
+				OutgoingTxs:       []types.OutgoingTxEntry{entry(2, 10)},
+				UnbatchedTxIds:    []uint64{2, 5},
+				VoucherAccounting: accounting(10, 0),
+			},
+			expBroken: "unbatched-index",
+		},
+		"tx in two batches": {
+			state: types.GenesisState{
+				OutgoingTxs:       []types.OutgoingTxEntry{entry(2, 10)},
+				Batches:           []*types.OutgoingTxBatch{batch(1, 2), batch(2, 2)},
+				VoucherAccounting: accounting(10, 0),
+			},
+			expBroken: "batched-txs",
+		},
+		"tx batched and unbatched": {
+			state: types.GenesisState{
+				OutgoingTxs:       []types.OutgoingTxEntry{entry(2, 10)},
+				UnbatchedTxIds:    []uint64{2},
+				Batches:           []*types.OutgoingTxBatch{batch(1, 2)},
+				VoucherAccounting: accounting(10, 0),
+			},
+			expBroken: "batched-txs",
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			k, ctx, _ := keeper.CreateTestEnv(t)
+			spec.state.Params = types.DefaultParams()
+			keeper.InitGenesis(ctx, k, spec.state)
+
+			invariants := map[string]sdk.Invariant{
+				"outgoing-pool":   keeper.OutgoingPoolInvariant(k),
+				"unbatched-index": keeper.UnbatchedIndexInvariant(k),
+				"batched-txs":     keeper.BatchedTxsInvariant(k),
+			}
+			for name, invariant := range invariants {
+				res, broken := invariant(ctx)
+				assert.Equal(t, name == spec.expBroken, broken, "%s: %s", name, res)
+			}
+		})
+	}
+}
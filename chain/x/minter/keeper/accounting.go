@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetVoucherAccounting returns the vouchers minted, burned and released by the module
+func (k Keeper) GetVoucherAccounting(ctx sdk.Context) types.VoucherAccounting {
+	var accounting types.VoucherAccounting
+	if bz := ctx.KVStore(k.storeKey).Get(types.VoucherAccountingKey); bz != nil {
+		k.cdc.MustUnmarshalBinaryBare(bz, &accounting)
+	}
+
+	return accounting
+}
+
+func (k Keeper) setVoucherAccounting(ctx sdk.Context, accounting types.VoucherAccounting) {
+	ctx.KVStore(k.storeKey).Set(types.VoucherAccountingKey, k.cdc.MustMarshalBinaryBare(&accounting))
+}
+
+// bootstrapVoucherAccounting starts the accounting of a chain which has run before the vouchers were accounted. The
+// vouchers pending in the outgoing pool are accounted as minted and burned, the supply of the vouchers is accounted by
+// the peggy module.
+func (k Keeper) bootstrapVoucherAccounting(ctx sdk.Context) {
+	pending := sdk.Coins{}
+	k.iteratePoolEntries(ctx, func(_ uint64, tx types.OutgoingTx) {
+		pending = pending.Add(tx.Amount)
+	})
+
+	k.setVoucherAccounting(ctx, types.VoucherAccounting{Minted: pending, Burned: pending})
+}
+
+// mintVouchers mints the vouchers to the module account and accounts them as minted
+func (k Keeper) mintVouchers(ctx sdk.Context, vouchers sdk.Coins) error {
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers)
+	}
+
+	accounting := k.GetVoucherAccounting(ctx)
+	accounting.Minted = accounting.Minted.Add(vouchers...)
+	k.setVoucherAccounting(ctx, accounting)
+
+	return nil
+}
+
+// burnVouchers burns the vouchers of the module account and accounts them as burned, they stay pending in the outgoing
+// pool until they are released
+func (k Keeper) burnVouchers(ctx sdk.Context, vouchers sdk.Coins) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, vouchers); err != nil {
+		return err
+	}
+
+	accounting := k.GetVoucherAccounting(ctx)
+	accounting.Burned = accounting.Burned.Add(vouchers...)
+	k.setVoucherAccounting(ctx, accounting)
+
+	return nil
+}
+
+// releaseVouchers accounts the burned vouchers of an outgoing tx which has left the pool
+func (k Keeper) releaseVouchers(ctx sdk.Context, vouchers sdk.Coins) {
+	accounting := k.GetVoucherAccounting(ctx)
+	accounting.Released = accounting.Released.Add(vouchers...)
+	k.setVoucherAccounting(ctx, accounting)
+}
@@ -7,8 +7,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-const gweiInEth = 1e9
-
 var minDepositAmount = sdk.NewInt(100)
 
 // AttestationHandler processes `observed` Attestations
@@ -39,8 +37,8 @@ func (a *AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, clai
 			return sdkerrors.Wrapf(err, "coin is not valid")
 		}
 		vouchers := sdk.Coins{coin}
-		if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
-			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers)
+		if err := a.keeper.mintVouchers(ctx, vouchers); err != nil {
+			return err
 		}
 
 		addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
@@ -115,27 +113,13 @@ func (a *AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, clai
 		commission := sdk.NewCoin(denom, claim.Amount.ToDec().Mul(a.keeper.oracleKeeper.GetCommissionForDemon(ctx, denom)).RoundInt())
 
 		fee := sdk.NewCoin(denom, claim.Fee)
-		feeIsOk := false
 
-		coinPrice, err := a.keeper.oracleKeeper.GetMinterPrice(ctx, claim.CoinId)
+		feeIsOk, err := a.isWithdrawFeeSufficient(ctx, claim.CoinId, fee.Amount)
 		if err != nil {
-			return sdkerrors.Wrap(err, "fee")
-		}
-
-		gasPrice, err := a.keeper.oracleKeeper.GetEthGasPrice(ctx)
-		if err != nil {
-			return sdkerrors.Wrap(err, "gas price")
-		}
-
-		ethPrice, err := a.keeper.oracleKeeper.GetEthPrice(ctx)
-		if err != nil {
-			return sdkerrors.Wrap(err, "eth price")
-		}
-
-		totalUsdCommission := fee.Amount.Mul(coinPrice).Quo(a.keeper.oracleKeeper.GetPipInBip())
-		totalUsdGas := gasPrice.Mul(ethPrice).MulRaw(int64(a.keeper.oracleKeeper.GetMinSingleWithdrawGas(ctx))).QuoRaw(gweiInEth).QuoRaw(a.keeper.oracleKeeper.GetGasUnits())
-		if totalUsdCommission.GTE(totalUsdGas) {
-			feeIsOk = true
+			// the fee can not be checked without a reliable price, so the deposit is refunded instead of failing the
+			// claim, a failed claim would leave the deposit stuck
+			a.keeper.logger(ctx).Info("refunding withdrawal because of unreliable price", "tx", claim.TxHash, "err", err.Error())
+			feeIsOk = false
 		}
 
 		if claim.Amount.LTE(claim.Fee) {
@@ -177,3 +161,20 @@ func (a *AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, clai
 	}
 	return nil
 }
+
+// isWithdrawFeeSufficient tells whether the fee covers the cost of a single withdrawal on Ethereum
+func (a AttestationHandler) isWithdrawFeeSufficient(ctx sdk.Context, coinId uint64, fee sdk.Int) (bool, error) {
+	coinPrice, err := a.keeper.oracleKeeper.GetMinterPrice(ctx, coinId)
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "fee")
+	}
+
+	totalUsdGas, err := a.keeper.oracleKeeper.EstimateWithdrawCostUSD(ctx, a.keeper.oracleKeeper.GetMinSingleWithdrawGas(ctx))
+	if err != nil {
+		return false, sdkerrors.Wrap(err, "gas cost")
+	}
+
+	totalUsdCommission := coinPrice.MulInt(fee).QuoInt(a.keeper.oracleKeeper.GetPipInBip())
+
+	return totalUsdCommission.GTE(totalUsdGas), nil
+}
@@ -28,14 +28,20 @@ func (k Keeper) BuildOutgoingTXBatch(ctx sdk.Context, maxElements int) (*types.O
 		return nil, err
 	}
 
+	return k.storeNewBatch(ctx, selectedTx, k.autoIncrementID(ctx, types.MinterNonce)), nil
+}
+
+// storeNewBatch persists a batch of the given txs with the given Minter nonce under the next batch nonce
+func (k Keeper) storeNewBatch(ctx sdk.Context, txs []*types.OutgoingTransferTx, minterNonce uint64) *types.OutgoingTxBatch {
 	nextID := k.autoIncrementID(ctx, types.KeyLastOutgoingBatchID)
-	minterNonce := k.autoIncrementID(ctx, types.MinterNonce)
 	batch := &types.OutgoingTxBatch{
 		BatchNonce:   nextID,
 		MinterNonce:  minterNonce,
-		Transactions: selectedTx,
+		Transactions: txs,
+		Block:        uint64(ctx.BlockHeight()),
 	}
 	k.storeBatch(ctx, batch)
+	k.setSigningCheck(ctx, types.GetBatchSigningCheckKey(batch.BatchNonce), batch.BatchNonce)
 
 	batchEvent := sdk.NewEvent(
 		types.EventTypeOutgoingBatch,
@@ -46,32 +52,40 @@ func (k Keeper) BuildOutgoingTXBatch(ctx sdk.Context, maxElements int) (*types.O
 		sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
 	)
 
-	for _, tx := range selectedTx {
+	for _, tx := range txs {
 		batchEvent = batchEvent.AppendAttributes(sdk.NewAttribute(types.AttributeKeyTxHash, tx.TxHash))
 	}
 
 	ctx.EventManager().EmitEvent(batchEvent)
-	return batch, nil
+	return batch
 }
 
 // OutgoingTxBatchExecuted is run when the Cosmos chain detects that a batch has been executed on Ethereum
-// It frees all the transactions in the batch, then cancels all earlier batches
+// It frees all the transactions in the batch, then cancels all earlier batches. A timed out batch which was executed
+// on Minter anyway executes its replacement, the replacement holds the same txs under the same Minter nonce.
 func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, nonce uint64, hash string) error {
 	b := k.GetOutgoingTXBatch(ctx, nonce)
+	if b == nil {
+		b = k.getTimedOutBatchReplacement(ctx, nonce)
+	}
 	if b == nil {
 		return sdkerrors.Wrap(types.ErrUnknown, "nonce")
 	}
 
 	// cleanup outgoing TX pool
 	for _, tx := range b.Transactions {
+		if entry, err := k.getPoolEntry(ctx, tx.Id); err == nil {
+			k.releaseVouchers(ctx, sdk.Coins{entry.Amount})
+		}
 		k.removePoolEntry(ctx, tx.Id)
 	}
 
 	// Iterate through remaining batches
 	k.IterateOutgoingTXBatches(ctx, func(key []byte, iter_batch *types.OutgoingTxBatch) bool {
-		// If the iterated batches nonce is lower than the one that was just executed, cancel it
+		// If the iterated batches Minter nonce is lower than the one that was just executed, it can not be executed
+		// anymore, cancel it. The batch nonce is not compared since the batches of timed out ones reuse their Minter nonce.
 		// TODO: iterate only over batches we need to iterate over
-		if iter_batch.BatchNonce < b.BatchNonce {
+		if iter_batch.MinterNonce < b.MinterNonce {
 			k.CancelOutgoingTXBatch(ctx, iter_batch.BatchNonce)
 		}
 
@@ -81,6 +95,9 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, nonce uint64, hash stri
 	// Delete batch since it is finished
 	k.deleteBatch(ctx, *b)
 
+	// the timed out batches with this or an earlier Minter nonce can not be executed anymore
+	k.deleteTimedOutBatches(ctx, b.MinterNonce)
+
 	batchEventExecuted := sdk.NewEvent(
 		types.EventTypeOutgoingBatchExecuted,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -122,6 +139,26 @@ func (k Keeper) storeBatch(ctx sdk.Context, batch *types.OutgoingTxBatch) {
 func (k Keeper) deleteBatch(ctx sdk.Context, batch types.OutgoingTxBatch) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.GetOutgoingTxBatchKey(batch.BatchNonce))
+
+	// the confirms are kept until the signers are checked
+	if !k.hasBatchSigningCheck(ctx, batch.BatchNonce) {
+		k.deleteBatchConfirms(ctx, batch.BatchNonce)
+	}
+}
+
+func (k Keeper) deleteBatchConfirms(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	var confirms []types.MsgConfirmBatch
+	k.IterateBatchConfirmByNonce(ctx, nonce, func(_ []byte, confirm types.MsgConfirmBatch) bool {
+		confirms = append(confirms, confirm)
+		return false
+	})
+
+	for _, batchConf := range confirms {
+		addr, _ := sdk.AccAddressFromBech32(batchConf.Validator)
+		store.Delete(types.GetBatchConfirmKey(batchConf.Nonce, addr))
+	}
 }
 
 // pickUnbatchedTX find TX in pool and remove from "available" second index
@@ -184,6 +221,98 @@ func (k Keeper) CancelOutgoingTXBatch(ctx sdk.Context, nonce uint64) error {
 	return nil
 }
 
+// CancelTimedOutBatches cancels the batches which were not executed within the batch timeout and batches their txs
+// again. The replacement batch reuses the Minter nonce of the timed out one, so the nonces of the multisig stay
+// contiguous and only one of the two batches can ever be executed on Minter. The replacement takes over exactly the txs
+// of the timed out batch, so it is never empty and a late withdraw claim of the timed out batch pays out the same txs.
+func (k Keeper) CancelTimedOutBatches(ctx sdk.Context) {
+	timeout := k.GetParams(ctx).BatchTimeoutBlocks
+	if timeout == 0 {
+		return
+	}
+
+	var timedOut []*types.OutgoingTxBatch
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		if batch.Block+timeout <= uint64(ctx.BlockHeight()) {
+			timedOut = append(timedOut, batch)
+		}
+		return false
+	})
+
+	// the batches are iterated in DESC order, the older ones are batched again first
+	for i := len(timedOut) - 1; i >= 0; i-- {
+		batch := timedOut[i]
+		if err := k.CancelOutgoingTXBatch(ctx, batch.BatchNonce); err != nil {
+			k.logger(ctx).Error("could not cancel timed out batch", "nonce", batch.BatchNonce, "err", err.Error())
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeOutgoingBatchTimeout,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(batch.BatchNonce)),
+			sdk.NewAttribute(types.AttributeKeyMinterNonce, fmt.Sprint(batch.MinterNonce)),
+		))
+
+		for _, tx := range batch.Transactions {
+			// the tx has just been returned to the index by the cancellation
+			if err := k.removeFromUnbatchedTXIndex(ctx, tx.Id); err != nil {
+				panic(err)
+			}
+		}
+
+		k.storeNewBatch(ctx, batch.Transactions, batch.MinterNonce)
+		k.setTimedOutBatch(ctx, types.TimedOutBatch{BatchNonce: batch.BatchNonce, MinterNonce: batch.MinterNonce})
+	}
+}
+
+func (k Keeper) setTimedOutBatch(ctx sdk.Context, tombstone types.TimedOutBatch) {
+	ctx.KVStore(k.storeKey).Set(types.GetTimedOutBatchKey(tombstone.BatchNonce), k.cdc.MustMarshalBinaryBare(&tombstone))
+}
+
+// GetTimedOutBatches returns the tombstones of the timed out batches which may still be executed on Minter
+func (k Keeper) GetTimedOutBatches(ctx sdk.Context) (tombstones []types.TimedOutBatch) {
+	iterate(ctx.KVStore(k.storeKey), types.TimedOutBatchKey, func(_ []byte, value []byte) {
+		var tombstone types.TimedOutBatch
+		k.cdc.MustUnmarshalBinaryBare(value, &tombstone)
+		tombstones = append(tombstones, tombstone)
+	})
+
+	return tombstones
+}
+
+// getTimedOutBatchReplacement returns the pending batch with the Minter nonce of the timed out batch, nil if the batch
+// did not time out or there is no such batch
+func (k Keeper) getTimedOutBatchReplacement(ctx sdk.Context, nonce uint64) *types.OutgoingTxBatch {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetTimedOutBatchKey(nonce))
+	if bz == nil {
+		return nil
+	}
+
+	var tombstone types.TimedOutBatch
+	k.cdc.MustUnmarshalBinaryBare(bz, &tombstone)
+
+	var replacement *types.OutgoingTxBatch
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		if batch.MinterNonce == tombstone.MinterNonce {
+			replacement = batch
+		}
+		return replacement != nil
+	})
+
+	return replacement
+}
+
+// deleteTimedOutBatches deletes the tombstones of the timed out batches with the given or an earlier Minter nonce
+func (k Keeper) deleteTimedOutBatches(ctx sdk.Context, minterNonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	for _, tombstone := range k.GetTimedOutBatches(ctx) {
+		if tombstone.MinterNonce <= minterNonce {
+			store.Delete(types.GetTimedOutBatchKey(tombstone.BatchNonce))
+		}
+	}
+}
+
 // IterateOutgoingTXBatches iterates through all outgoing batches in DESC order.
 func (k Keeper) IterateOutgoingTXBatches(ctx sdk.Context, cb func(key []byte, batch *types.OutgoingTxBatch) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXBatchKey)
@@ -2,26 +2,207 @@ package keeper
 
 import (
 	"encoding/binary"
+
 	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	store := ctx.KVStore(keeper.storeKey)
+
 	keeper.setParams(ctx, data.Params)
-	ctx.KVStore(keeper.storeKey).Set(types.MinterNonce, sdk.Uint64ToBigEndian(data.StartMinterNonce))
+	store.Set(types.MinterNonce, sdk.Uint64ToBigEndian(data.StartMinterNonce))
+	if data.StartTxPoolId != 0 {
+		store.Set(types.KeyLastTXPoolID, sdk.Uint64ToBigEndian(data.StartTxPoolId))
+	}
+	if data.StartBatchNonce != 0 {
+		store.Set(types.KeyLastOutgoingBatchID, sdk.Uint64ToBigEndian(data.StartBatchNonce))
+	}
+
+	for _, valset := range data.Valsets {
+		keeper.storeValset(ctx, valset)
+	}
+	for _, confirm := range data.ValsetConfirms {
+		keeper.SetValsetConfirm(ctx, *confirm)
+	}
+	if data.LastValset != nil {
+		keeper.setLastValset(ctx, data.LastValset)
+	}
+
+	for _, entry := range data.OutgoingTxs {
+		tx := entry.Tx
+		if err := keeper.setPoolEntry(ctx, entry.Id, &tx); err != nil {
+			panic(err)
+		}
+	}
+	if len(data.UnbatchedTxIds) != 0 {
+		store.Set(types.SecondIndexOutgoingTXFeeKey, keeper.cdc.MustMarshalBinaryBare(&types.IDSet{Ids: data.UnbatchedTxIds}))
+	}
+
+	for _, batch := range data.Batches {
+		keeper.storeBatch(ctx, batch)
+	}
+	for _, confirm := range data.BatchConfirms {
+		keeper.SetBatchConfirm(ctx, confirm)
+	}
+	for _, tombstone := range data.TimedOutBatches {
+		keeper.setTimedOutBatch(ctx, tombstone)
+	}
+
+	for _, entry := range data.Attestations {
+		att := entry.Attestation
+		store.Set(types.GetAttestationKeyWithHash(att.EventNonce, entry.ClaimHash), keeper.cdc.MustMarshalBinaryBare(&att))
+	}
+	for _, claim := range data.Claims {
+		validator, err := sdk.ValAddressFromBech32(claim.Validator)
+		if err != nil {
+			panic(err)
+		}
+		store.Set(types.GetClaimKeyWithHash(claim.ClaimType, claim.EventNonce, validator, claim.ClaimHash), []byte{})
+	}
+	if data.LastObservedEventNonce != 0 {
+		keeper.setLastObservedEventNonce(ctx, data.LastObservedEventNonce)
+	}
+	for _, lastNonce := range data.LastEventNonces {
+		validator, err := sdk.ValAddressFromBech32(lastNonce.Validator)
+		if err != nil {
+			panic(err)
+		}
+		keeper.setLastEventNonceByValidator(ctx, validator, lastNonce.Nonce)
+	}
+
+	for _, addr := range data.MinterAddresses {
+		validator, err := sdk.AccAddressFromBech32(addr.Validator)
+		if err != nil {
+			panic(err)
+		}
+		keeper.SetMinterAddress(ctx, validator, addr.MinterAddress)
+	}
+
+	for _, check := range data.ValsetSigningChecks {
+		store.Set(types.GetValsetSigningCheckKey(check.Nonce), keeper.cdc.MustMarshalBinaryBare(&check))
+	}
+	for _, check := range data.BatchSigningChecks {
+		store.Set(types.GetBatchSigningCheckKey(check.Nonce), keeper.cdc.MustMarshalBinaryBare(&check))
+	}
+
+	// the genesis exported before the vouchers were accounted has no accounting, it is bootstrapped from the pool
+	if accounting := data.VoucherAccounting; accounting.Minted.Empty() && accounting.Burned.Empty() && accounting.Released.Empty() {
+		keeper.bootstrapVoucherAccounting(ctx)
+	} else {
+		keeper.setVoucherAccounting(ctx, accounting)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 	p := k.GetParams(ctx)
+	store := ctx.KVStore(k.storeKey)
+
+	state := types.GenesisState{
+		Params:                 &p,
+		StartMinterNonce:       getSequence(store, types.MinterNonce),
+		StartTxPoolId:          getSequence(store, types.KeyLastTXPoolID),
+		StartBatchNonce:        getSequence(store, types.KeyLastOutgoingBatchID),
+		LastValset:             k.GetLastValset(ctx),
+		LastObservedEventNonce: k.GetLastObservedEventNonce(ctx),
+		ValsetSigningChecks:    k.GetValsetSigningChecks(ctx),
+		BatchSigningChecks:     k.GetBatchSigningChecks(ctx),
+		VoucherAccounting:      k.GetVoucherAccounting(ctx),
+		TimedOutBatches:        k.GetTimedOutBatches(ctx),
+	}
+
+	iterate(store, types.ValsetRequestKey, func(_ []byte, value []byte) {
+		var valset types.Valset
+		k.cdc.MustUnmarshalBinaryBare(value, &valset)
+		state.Valsets = append(state.Valsets, &valset)
+	})
+
+	iterate(store, types.ValsetConfirmKey, func(_ []byte, value []byte) {
+		var confirm types.MsgValsetConfirm
+		k.cdc.MustUnmarshalBinaryBare(value, &confirm)
+		state.ValsetConfirms = append(state.ValsetConfirms, &confirm)
+	})
+
+	iterate(store, types.OutgoingTXPoolKey, func(key []byte, value []byte) {
+		var tx types.OutgoingTx
+		k.cdc.MustUnmarshalBinaryBare(value, &tx)
+		state.OutgoingTxs = append(state.OutgoingTxs, types.OutgoingTxEntry{
+			Id: binary.BigEndian.Uint64(key),
+			Tx: tx,
+		})
+	})
+
+	if bz := store.Get(types.SecondIndexOutgoingTXFeeKey); bz != nil {
+		var ids types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(bz, &ids)
+		state.UnbatchedTxIds = ids.Ids
+	}
 
-	bz := ctx.KVStore(k.storeKey).Get(types.MinterNonce)
-	var startMinterNonce uint64 = 1
-	if bz != nil {
-		startMinterNonce = binary.BigEndian.Uint64(bz)
+	iterate(store, types.OutgoingTXBatchKey, func(_ []byte, value []byte) {
+		var batch types.OutgoingTxBatch
+		k.cdc.MustUnmarshalBinaryBare(value, &batch)
+		state.Batches = append(state.Batches, &batch)
+	})
+
+	iterate(store, types.BatchConfirmKey, func(_ []byte, value []byte) {
+		var confirm types.MsgConfirmBatch
+		k.cdc.MustUnmarshalBinaryBare(value, &confirm)
+		state.BatchConfirms = append(state.BatchConfirms, &confirm)
+	})
+
+	iterate(store, types.OracleAttestationKey, func(key []byte, value []byte) {
+		var att types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(value, &att)
+		state.Attestations = append(state.Attestations, types.AttestationEntry{
+			ClaimHash:   key[len(types.UInt64Bytes(0)):],
+			Attestation: att,
+		})
+	})
+
+	// the claim key consists of the claim type, the validator, the event nonce and the claim details hash
+	iterate(store, types.OracleClaimKey, func(key []byte, _ []byte) {
+		nonceStart := 1 + sdk.AddrLen
+		state.Claims = append(state.Claims, types.ClaimEntry{
+			ClaimType:  types.ClaimType(key[0]),
+			Validator:  sdk.ValAddress(key[1:nonceStart]).String(),
+			EventNonce: types.UInt64FromBytes(key[nonceStart : nonceStart+len(types.UInt64Bytes(0))]),
+			ClaimHash:  key[nonceStart+len(types.UInt64Bytes(0)):],
+		})
+	})
+
+	iterate(store, types.LastEventNonceByValidatorKey, func(key []byte, value []byte) {
+		state.LastEventNonces = append(state.LastEventNonces, types.LastEventNonce{
+			Validator: sdk.ValAddress(key).String(),
+			Nonce:     types.UInt64FromBytes(value),
+		})
+	})
+
+	iterate(store, types.MinterAddressKey, func(key []byte, value []byte) {
+		state.MinterAddresses = append(state.MinterAddresses, types.MinterAddress{
+			Validator:     sdk.AccAddress(key).String(),
+			MinterAddress: string(value),
+		})
+	})
+
+	return state
+}
+
+// getSequence returns the next value of the sequence
+func getSequence(store sdk.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 1
 	}
 
-	return types.GenesisState{
-		Params:           &p,
-		StartMinterNonce: startMinterNonce,
+	return binary.BigEndian.Uint64(bz)
+}
+
+func iterate(store sdk.KVStore, keyPrefix []byte, cb func(key []byte, value []byte)) {
+	iter := prefix.NewStore(store, keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		cb(iter.Key(), iter.Value())
 	}
 }
@@ -73,6 +73,7 @@ func (k Keeper) SetValsetRequest(ctx sdk.Context) *types.Valset {
 
 	valset.MinterNonce = k.autoIncrementID(ctx, types.MinterNonce)
 	k.storeValset(ctx, valset)
+	k.setSigningCheck(ctx, types.GetValsetSigningCheckKey(valset.Nonce), valset.Nonce)
 
 	event := sdk.NewEvent(
 		types.EventTypeMultisigUpdateRequest,
@@ -94,17 +95,25 @@ func (k Keeper) storeValset(ctx sdk.Context, valset *types.Valset) {
 func (k Keeper) deleteValset(ctx sdk.Context, valset *types.Valset) {
 	store := ctx.KVStore(k.storeKey)
 
-	// delete confirms
-	confirms := k.GetAllValsetConfirmsByNonce(ctx, valset.Nonce)
-	for _, valsetConf := range confirms {
-		addr, _ := sdk.AccAddressFromBech32(valsetConf.Validator)
-		store.Delete(types.GetValsetConfirmKey(valsetConf.Nonce, addr))
+	// the confirms are kept until the signers are checked
+	if !k.hasValsetSigningCheck(ctx, valset.Nonce) {
+		k.deleteValsetConfirms(ctx, valset.Nonce)
 	}
 
 	// delete valset
 	store.Delete(types.GetValsetRequestKey(valset.Nonce))
 }
 
+func (k Keeper) deleteValsetConfirms(ctx sdk.Context, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+
+	confirms := k.GetAllValsetConfirmsByNonce(ctx, nonce)
+	for _, valsetConf := range confirms {
+		addr, _ := sdk.AccAddressFromBech32(valsetConf.Validator)
+		store.Delete(types.GetValsetConfirmKey(valsetConf.Nonce, addr))
+	}
+}
+
 // HasValsetRequest returns true if a valset defined by a nonce exists
 func (k Keeper) HasValsetRequest(ctx sdk.Context, nonce uint64) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -325,6 +334,18 @@ func (k Keeper) GetBridgeContractAddress(ctx sdk.Context) string {
 	return a
 }
 
+// GetMinterChainID returns the chain id of the Minter network the multisig txs are signed for
+func (k Keeper) GetMinterChainID(ctx sdk.Context) uint64 {
+	var a uint64
+	k.paramSpace.Get(ctx, types.ParamsStoreKeyMinterChainID, &a)
+	return a
+}
+
+// SetMinterChainID sets the chain id of the Minter network the multisig txs are signed for
+func (k Keeper) SetMinterChainID(ctx sdk.Context, chainID uint64) {
+	k.paramSpace.Set(ctx, types.ParamsStoreKeyMinterChainID, chainID)
+}
+
 // GetBridgeChainID returns the chain id of the ETH chain we are running against
 func (k Keeper) GetBridgeChainID(ctx sdk.Context) uint64 {
 	var a uint64
@@ -428,8 +449,8 @@ func (k Keeper) ColdStorageTransfer(ctx sdk.Context, c *types.ColdStorageTransfe
 		}
 
 		vouchers := sdk.Coins{coin}
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
-			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers)
+		if err := k.mintVouchers(ctx, vouchers); err != nil {
+			return err
 		}
 
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, defaultSender, vouchers); err != nil {
@@ -445,33 +466,16 @@ func (k Keeper) ColdStorageTransfer(ctx sdk.Context, c *types.ColdStorageTransfe
 			return sdkerrors.Wrap(err, "fee")
 		}
 
-		nextID := k.autoIncrementID(ctx, types.KeyLastOutgoingBatchID)
-		minterNonce := k.autoIncrementID(ctx, types.MinterNonce)
-		batch := &types.OutgoingTxBatch{
-			BatchNonce:  nextID,
-			MinterNonce: minterNonce,
-			Transactions: []*types.OutgoingTransferTx{
-				{
-					Id:          txID,
-					Sender:      defaultSender.String(),
-					DestAddress: coldStorageAddr,
-					MinterToken: minterCoin,
-					TxHash:      "",
-				},
+		// the cold storage batch is signed like any other batch, so it is checked for the missing signatures as well
+		k.storeNewBatch(ctx, []*types.OutgoingTransferTx{
+			{
+				Id:          txID,
+				Sender:      defaultSender.String(),
+				DestAddress: coldStorageAddr,
+				MinterToken: minterCoin,
+				TxHash:      "",
 			},
-		}
-		k.storeBatch(ctx, batch)
-
-		batchEvent := sdk.NewEvent(
-			types.EventTypeOutgoingBatch,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeKeyContract, k.GetBridgeContractAddress(ctx)),
-			sdk.NewAttribute(types.AttributeKeyBridgeChainID, strconv.Itoa(int(k.GetBridgeChainID(ctx)))),
-			sdk.NewAttribute(types.AttributeKeyOutgoingBatchID, fmt.Sprint(nextID)),
-			sdk.NewAttribute(types.AttributeKeyNonce, fmt.Sprint(nextID)),
-		)
-
-		ctx.EventManager().EmitEvent(batchEvent)
+		}, k.autoIncrementID(ctx, types.MinterNonce))
 	}
 
 	return nil
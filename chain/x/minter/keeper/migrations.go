@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateParams sets the default values of the params which were added after the chain has started, GetParams panics
+// on the params which are missing in the store
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	for _, pair := range types.DefaultParams().ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
+
+// MigrateVoucherAccounting bootstraps the voucher accounting of a chain which has run before the vouchers were accounted
+func (k Keeper) MigrateVoucherAccounting(ctx sdk.Context) {
+	if !ctx.KVStore(k.storeKey).Has(types.VoucherAccountingKey) {
+		k.bootstrapVoucherAccounting(ctx)
+	}
+}
+
+// MigratePoolIndexes indexes the outgoing txs which were added to the pool before the sender, receiver and tx hash
+// indexes existed
+func (k Keeper) MigratePoolIndexes(ctx sdk.Context) {
+	var keys [][]byte
+	k.iteratePoolEntries(ctx, func(id uint64, tx types.OutgoingTx) {
+		keys = append(keys, poolIndexKeys(id, &tx)...)
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Set(key, []byte{0x1})
+	}
+}
+
+// MigrateAttestationKeys moves the attestations which were stored under the event nonce without a prefix to the
+// attestation prefix
+func (k Keeper) MigrateAttestationKeys(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// the event nonces are far below 2^56, so the unprefixed keys go before all the prefixed ones
+	var keys, values [][]byte
+	iter := store.Iterator(nil, types.MinterAddressKey)
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		values = append(values, iter.Value())
+	}
+	iter.Close()
+
+	for i, key := range keys {
+		store.Delete(key)
+		store.Set(append(types.OracleAttestationKey, key...), values[i])
+	}
+}
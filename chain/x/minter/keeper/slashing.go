@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// setSigningCheck records the validators bonded at the moment, they are expected to sign the valset or the batch
+// stored under the given nonce
+func (k Keeper) setSigningCheck(ctx sdk.Context, key []byte, nonce uint64) {
+	check := types.SigningCheck{
+		Nonce:  nonce,
+		Height: ctx.BlockHeight(),
+	}
+
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		check.Validators = append(check.Validators, validator.GetOperator().String())
+	}
+
+	ctx.KVStore(k.storeKey).Set(key, k.cdc.MustMarshalBinaryBare(&check))
+}
+
+// GetValsetSigningChecks returns the pending signing checks of valsets
+func (k Keeper) GetValsetSigningChecks(ctx sdk.Context) []types.SigningCheck {
+	return k.getSigningChecks(ctx, types.ValsetSigningCheckKey)
+}
+
+// GetBatchSigningChecks returns the pending signing checks of batches
+func (k Keeper) GetBatchSigningChecks(ctx sdk.Context) []types.SigningCheck {
+	return k.getSigningChecks(ctx, types.BatchSigningCheckKey)
+}
+
+func (k Keeper) getSigningChecks(ctx sdk.Context, keyPrefix []byte) (checks []types.SigningCheck) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var check types.SigningCheck
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &check)
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+func (k Keeper) hasValsetSigningCheck(ctx sdk.Context, nonce uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetValsetSigningCheckKey(nonce))
+}
+
+func (k Keeper) hasBatchSigningCheck(ctx sdk.Context, nonce uint64) bool {
+	return ctx.KVStore(k.storeKey).Has(types.GetBatchSigningCheckKey(nonce))
+}
+
+// SlashUnsignedValsets punishes the validators which did not sign a valset within the signed valsets window. Every
+// valset is checked once, its confirms are pruned after the check if the valset is already executed.
+func (k Keeper) SlashUnsignedValsets(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	for _, check := range k.GetValsetSigningChecks(ctx) {
+		// the checks are ordered by nonce, so the later ones are not due either
+		if check.Height+int64(params.SignedValsetsWindow) >= ctx.BlockHeight() {
+			return
+		}
+
+		for _, operator := range check.Validators {
+			valAddr, err := sdk.ValAddressFromBech32(operator)
+			if err != nil {
+				continue
+			}
+
+			if k.GetValsetConfirm(ctx, check.Nonce, sdk.AccAddress(valAddr)) == nil {
+				k.punishValidator(ctx, valAddr, check.Height, params.SlashFractionValset, "valset not signed")
+			}
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.GetValsetSigningCheckKey(check.Nonce))
+
+		if !k.HasValsetRequest(ctx, check.Nonce) {
+			k.deleteValsetConfirms(ctx, check.Nonce)
+		}
+	}
+}
+
+// SlashUnsignedBatches punishes the validators which did not sign a batch within the signed batches window. Every
+// batch is checked once, also when it is executed, cancelled or timed out within the window. Its confirms are pruned
+// after the check if the batch is already removed.
+func (k Keeper) SlashUnsignedBatches(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	for _, check := range k.GetBatchSigningChecks(ctx) {
+		// the checks are ordered by nonce, so the later ones are not due either
+		if check.Height+int64(params.SignedBatchesWindow) >= ctx.BlockHeight() {
+			return
+		}
+
+		for _, operator := range check.Validators {
+			valAddr, err := sdk.ValAddressFromBech32(operator)
+			if err != nil {
+				continue
+			}
+
+			if k.GetBatchConfirm(ctx, check.Nonce, sdk.AccAddress(valAddr)) == nil {
+				k.punishValidator(ctx, valAddr, check.Height, params.SlashFractionBatch, "batch not signed")
+			}
+		}
+
+		ctx.KVStore(k.storeKey).Delete(types.GetBatchSigningCheckKey(check.Nonce))
+
+		if k.GetOutgoingTXBatch(ctx, check.Nonce) == nil {
+			k.deleteBatchConfirms(ctx, check.Nonce)
+		}
+	}
+}
+
+// punishValidator slashes the validator for the infraction at the given height and jails it. The validators which
+// have already left the validator set are skipped.
+func (k Keeper) punishValidator(ctx sdk.Context, valAddr sdk.ValAddress, infractionHeight int64, fraction sdk.Dec, reason string) {
+	validator := k.StakingKeeper.Validator(ctx, valAddr)
+	if validator == nil || validator.IsUnbonded() {
+		return
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.logger(ctx).Error("could not punish validator", "validator", valAddr.String(), "err", err.Error())
+		return
+	}
+
+	k.StakingKeeper.Slash(ctx, consAddr, infractionHeight, validator.GetConsensusPower(), fraction)
+	if !validator.IsJailed() {
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSlash,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, valAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
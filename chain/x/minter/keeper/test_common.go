@@ -6,10 +6,12 @@ import (
 	"time"
 
 	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	oracletypes "github.com/MinterTeam/mhub/chain/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	ccodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -91,6 +93,7 @@ type TestKeepers struct {
 	DistKeeper    distrkeeper.Keeper
 	BankKeeper    bankkeeper.Keeper
 	GovKeeper     govkeeper.Keeper
+	OracleKeeper  oraclekeeper.Keeper
 }
 
 // CreateTestEnv creates the keeper testing environment for peggy
@@ -104,6 +107,7 @@ func CreateTestEnv(t *testing.T) (Keeper, sdk.Context, TestKeepers) {
 	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
 	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
 	keyGov := sdk.NewKVStoreKey(govtypes.StoreKey)
+	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
 
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
@@ -115,6 +119,7 @@ func CreateTestEnv(t *testing.T) (Keeper, sdk.Context, TestKeepers) {
 	ms.MountStoreWithDB(keyDistro, sdk.StoreTypeIAVL, db)
 	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
 	ms.MountStoreWithDB(keyGov, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
 	err := ms.LoadLatestVersion()
 	require.Nil(t, err)
 
@@ -224,11 +229,16 @@ func CreateTestEnv(t *testing.T) (Keeper, sdk.Context, TestKeepers) {
 		GovKeeper:     govKeeper,
 	}
 
-	k := NewKeeper(marshaler, peggyKey, paramsKeeper.Subspace(types.DefaultParamspace), stakingKeeper, bankKeeper, oraclekeeper.Keeper{})
+	oracleKeeper := oraclekeeper.NewKeeper(marshaler, keyOracle, paramsKeeper.Subspace(oracletypes.ModuleName), stakingKeeper, bankKeeper)
+	oracleKeeper.SetParams(ctx, *oracletypes.DefaultParams())
+	keepers.OracleKeeper = oracleKeeper
+
+	k := NewKeeper(marshaler, peggyKey, paramsKeeper.Subspace(types.DefaultParamspace), stakingKeeper, bankKeeper, oracleKeeper)
 	k.setParams(ctx, &types.Params{
 		StartThreshold: 0,
 		MinterAddress:  "Mx8858eeb3dfffa017d4bce9801d340d36cf895ccf",
 		BridgeChainId:  11,
+		MinterChainId:  types.MinterTestnetChainID,
 	})
 	return k, ctx, keepers
 }
@@ -272,12 +282,12 @@ func NewStakingKeeperMock(operators ...sdk.ValAddress) *StakingKeeperMock {
 	r := &StakingKeeperMock{
 		BondedValidators: make([]stakingtypes.Validator, 0),
 		ValidatorPower:   make(map[string]int64, 0),
+		Slashed:          map[string]sdk.Dec{},
+		Jailed:           map[string]bool{},
 	}
 	const defaultTestPower = 100
 	for _, a := range operators {
-		r.BondedValidators = append(r.BondedValidators, stakingtypes.Validator{
-			OperatorAddress: a.String(),
-		})
+		r.BondedValidators = append(r.BondedValidators, mockValidator(a))
 		r.ValidatorPower[a.String()] = defaultTestPower
 	}
 	return r
@@ -294,25 +304,69 @@ func NewStakingKeeperWeightedMock(t ...MockStakingValidatorData) *StakingKeeperM
 	r := &StakingKeeperMock{
 		BondedValidators: make([]stakingtypes.Validator, len(t)),
 		ValidatorPower:   make(map[string]int64, len(t)),
+		Slashed:          map[string]sdk.Dec{},
+		Jailed:           map[string]bool{},
 	}
 
 	for i, a := range t {
-		r.BondedValidators[i] = stakingtypes.Validator{
-			OperatorAddress: a.Operator.String(),
-		}
+		r.BondedValidators[i] = mockValidator(a.Operator)
 		r.ValidatorPower[a.Operator.String()] = a.Power
 	}
 	return r
 }
 
-// StakingKeeperMock is a mock staking keeper for use in the tests
+// mockValidator returns a bonded validator with a consensus key derived from its operator address
+func mockValidator(operator sdk.ValAddress) stakingtypes.Validator {
+	validator, err := stakingtypes.NewValidator(operator, ed25519.GenPrivKeyFromSecret(operator).PubKey(), stakingtypes.Description{})
+	if err != nil {
+		panic(err)
+	}
+	validator.Status = stakingtypes.Bonded
+
+	return validator
+}
+
+// StakingKeeperMock is a mock staking keeper for use in the tests, it records the slashed and jailed validators by
+// their operator address
 type StakingKeeperMock struct {
 	BondedValidators []stakingtypes.Validator
 	ValidatorPower   map[string]int64
+	Slashed          map[string]sdk.Dec
+	Jailed           map[string]bool
+}
+
+// Jail implements the interface for staking keeper required by minter
+func (s *StakingKeeperMock) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	if i := s.validatorIndex(consAddr); i >= 0 {
+		s.BondedValidators[i].Jailed = true
+		s.Jailed[s.BondedValidators[i].OperatorAddress] = true
+	}
 }
 
-func (s *StakingKeeperMock) Jail(context sdk.Context, address sdk.ConsAddress) {
-	panic("implement me")
+// Slash implements the interface for staking keeper required by minter
+func (s *StakingKeeperMock) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, fraction sdk.Dec) {
+	if i := s.validatorIndex(consAddr); i >= 0 {
+		s.Slashed[s.BondedValidators[i].OperatorAddress] = fraction
+	}
+}
+
+func (s *StakingKeeperMock) validatorIndex(consAddr sdk.ConsAddress) int {
+	for i, validator := range s.BondedValidators {
+		if addr, err := validator.GetConsAddr(); err == nil && addr.Equals(consAddr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Validator implements the interface for staking keeper required by minter
+func (s *StakingKeeperMock) Validator(ctx sdk.Context, operator sdk.ValAddress) stakingtypes.ValidatorI {
+	for _, validator := range s.BondedValidators {
+		if validator.OperatorAddress == operator.String() {
+			return validator
+		}
+	}
+	return nil
 }
 
 // GetBondedValidatorsByPower implements the interface for staking keeper required by peggy
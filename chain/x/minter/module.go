@@ -113,8 +113,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants implements app module
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO: make some invariants in the peggy module to ensure that
-	// coins aren't being fraudlently minted etc...
+	keeper.RegisterInvariants(ir, am.keeper)
 }
 
 // Route implements app module
@@ -157,20 +156,7 @@ func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock implements app module
 func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
-
-	hasPendingValset := false
-	am.keeper.IterateValsetRequest(ctx, func(_ []byte, _ *types.Valset) bool {
-		hasPendingValset = true
-		return true
-	})
-
-	if !hasPendingValset {
-		lastValset := am.keeper.GetLastValset(ctx)
-		if lastValset == nil || types.BridgeValidators(am.keeper.GetCurrentValset(ctx).Members).PowerDiff(lastValset.Members) > 0.01 {
-			am.keeper.SetValsetRequest(ctx)
-		}
-	}
-
+	EndBlocker(ctx, am.keeper)
 	return []abci.ValidatorUpdate{}
 }
 
@@ -0,0 +1,146 @@
+package minter
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/minter/keeper"
+	"github.com/MinterTeam/mhub/chain/x/minter/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashUnsignedBatches(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+	var (
+		signer = sdk.ValAddress(append(make([]byte, sdk.AddrLen-1), 1))
+		absent = sdk.ValAddress(append(make([]byte, sdk.AddrLen-1), 2))
+	)
+
+	specs := map[string]struct {
+		blocksAfter int64
+		execute     bool
+		expSlashed  bool
+	}{
+		"window not expired":             {blocksAfter: 10},
+		"window expired":                 {blocksAfter: 11, expSlashed: true},
+		"batch executed, window open":    {blocksAfter: 10, execute: true},
+		"batch executed, window expired": {blocksAfter: 11, execute: true, expSlashed: true},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			k, ctx, _ := keeper.CreateTestEnv(t)
+			staking := keeper.NewStakingKeeperMock(signer, absent)
+			k.StakingKeeper = staking
+
+			params := types.DefaultParams()
+			params.SignedBatchesWindow = 10
+			keeper.InitGenesis(ctx, k, types.GenesisState{
+				Params:         params,
+				OutgoingTxs:    []types.OutgoingTxEntry{{Id: 1, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("usdc", 10)}}},
+				UnbatchedTxIds: []uint64{1},
+			})
+
+			batch, err := k.BuildOutgoingTXBatch(ctx, keeper.OutgoingTxBatchSize)
+			require.NoError(t, err)
+			require.Len(t, k.GetBatchSigningChecks(ctx), 1)
+			k.SetBatchConfirm(ctx, &types.MsgConfirmBatch{Nonce: batch.BatchNonce, Validator: sdk.AccAddress(signer).String()})
+
+			if spec.execute {
+				require.NoError(t, k.OutgoingTxBatchExecuted(ctx, batch.BatchNonce, "0xaa"))
+			}
+
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + spec.blocksAfter)
+			k.SlashUnsignedBatches(ctx)
+
+			assert.NotContains(t, staking.Slashed, signer.String())
+			if !spec.expSlashed {
+				assert.Empty(t, staking.Slashed)
+				assert.Empty(t, staking.Jailed)
+				assert.Len(t, k.GetBatchSigningChecks(ctx), 1)
+				assert.NotNil(t, k.GetBatchConfirm(ctx, batch.BatchNonce, sdk.AccAddress(signer)))
+				return
+			}
+
+			assert.Equal(t, params.SlashFractionBatch, staking.Slashed[absent.String()])
+			assert.True(t, staking.Jailed[absent.String()])
+			assert.Empty(t, k.GetBatchSigningChecks(ctx))
+
+			// the confirms of the executed batch are pruned with the check
+			assert.Equal(t, spec.execute, k.GetBatchConfirm(ctx, batch.BatchNonce, sdk.AccAddress(signer)) == nil)
+		})
+	}
+}
+
+func TestTimedOutBatchSigningCheck(t *testing.T) {
+	const receiver = "Mx7f0fc21d932f38ca9444f61703174569066cfa50"
+	absent := sdk.ValAddress(append(make([]byte, sdk.AddrLen-1), 1))
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	staking := keeper.NewStakingKeeperMock(absent)
+	k.StakingKeeper = staking
+
+	params := types.DefaultParams()
+	params.BatchTimeoutBlocks = 5
+	params.SignedBatchesWindow = 10
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params:         params,
+		OutgoingTxs:    []types.OutgoingTxEntry{{Id: 1, Tx: types.OutgoingTx{DestAddr: receiver, Amount: sdk.NewInt64Coin("usdc", 10)}}},
+		UnbatchedTxIds: []uint64{1},
+	})
+
+	batch, err := k.BuildOutgoingTXBatch(ctx, keeper.OutgoingTxBatchSize)
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	k.CancelTimedOutBatches(ctx)
+
+	// the timed out batch is still checked within its own window, the replacement gets a window of its own
+	checks := k.GetBatchSigningChecks(ctx)
+	require.Len(t, checks, 2)
+	assert.Equal(t, batch.BatchNonce, checks[0].Nonce)
+	assert.Equal(t, ctx.BlockHeight(), checks[1].Height)
+
+	k.SlashUnsignedBatches(ctx.WithBlockHeight(ctx.BlockHeight() + 6))
+	assert.Equal(t, params.SlashFractionBatch, staking.Slashed[absent.String()])
+	require.Len(t, k.GetBatchSigningChecks(ctx), 1)
+	assert.Equal(t, checks[1].Nonce, k.GetBatchSigningChecks(ctx)[0].Nonce)
+}
+
+func TestSlashUnsignedValsets(t *testing.T) {
+	var (
+		signer = sdk.ValAddress(append(make([]byte, sdk.AddrLen-1), 1))
+		absent = sdk.ValAddress(append(make([]byte, sdk.AddrLen-1), 2))
+		jailed = sdk.ValAddress(append(make([]byte, sdk.AddrLen-1), 3))
+	)
+
+	k, ctx, _ := keeper.CreateTestEnv(t)
+	staking := keeper.NewStakingKeeperMock(signer, absent, jailed)
+	staking.BondedValidators[2].Jailed = true
+	k.StakingKeeper = staking
+
+	params := types.DefaultParams()
+	params.SignedValsetsWindow = 10
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params: params,
+		ValsetConfirms: []*types.MsgValsetConfirm{
+			{Nonce: 5, Validator: sdk.AccAddress(signer).String()},
+		},
+		ValsetSigningChecks: []types.SigningCheck{
+			{Nonce: 5, Height: ctx.BlockHeight(), Validators: []string{signer.String(), absent.String(), jailed.String()}},
+		},
+	})
+
+	k.SlashUnsignedValsets(ctx.WithBlockHeight(ctx.BlockHeight() + 10))
+	assert.Empty(t, staking.Slashed)
+	require.Len(t, k.GetValsetSigningChecks(ctx), 1)
+
+	k.SlashUnsignedValsets(ctx.WithBlockHeight(ctx.BlockHeight() + 11))
+	assert.Equal(t, map[string]sdk.Dec{absent.String(): params.SlashFractionValset, jailed.String(): params.SlashFractionValset}, staking.Slashed)
+	// the validator which is already jailed is slashed, but not jailed again
+	assert.Equal(t, map[string]bool{absent.String(): true}, staking.Jailed)
+	assert.Empty(t, k.GetValsetSigningChecks(ctx))
+
+	// the valset is executed already, so its confirms are pruned with the check
+	assert.Empty(t, k.GetAllValsetConfirmsByNonce(ctx, 5))
+}
@@ -26,6 +26,7 @@ type OutgoingTxBatch struct {
 	BatchNonce   uint64                `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
 	MinterNonce  uint64                `protobuf:"varint,2,opt,name=minter_nonce,json=minterNonce,proto3" json:"minter_nonce,omitempty"`
 	Transactions []*OutgoingTransferTx `protobuf:"bytes,3,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Block        uint64                `protobuf:"varint,4,opt,name=block,proto3" json:"block,omitempty"`
 }
 
 func (m *OutgoingTxBatch) Reset()         { *m = OutgoingTxBatch{} }
@@ -82,6 +83,13 @@ func (m *OutgoingTxBatch) GetTransactions() []*OutgoingTransferTx {
 	return nil
 }
 
+func (m *OutgoingTxBatch) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
 type OutgoingTransferTx struct {
 	Id          uint64      `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	Sender      string      `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
@@ -158,37 +166,93 @@ func (m *OutgoingTransferTx) GetTxHash() string {
 	return ""
 }
 
+// TimedOutBatch is the tombstone of a batch which was cancelled by the batch timeout. The batch may still have been
+// executed on Minter, its late withdraw claim executes the replacement batch with the same Minter nonce instead.
+type TimedOutBatch struct {
+	BatchNonce  uint64 `protobuf:"varint,1,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+	MinterNonce uint64 `protobuf:"varint,2,opt,name=minter_nonce,json=minterNonce,proto3" json:"minter_nonce,omitempty"`
+}
+
+func (m *TimedOutBatch) Reset()         { *m = TimedOutBatch{} }
+func (m *TimedOutBatch) String() string { return proto.CompactTextString(m) }
+func (*TimedOutBatch) ProtoMessage()    {}
+func (*TimedOutBatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bfc5e5d918be8aee, []int{2}
+}
+func (m *TimedOutBatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TimedOutBatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TimedOutBatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TimedOutBatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TimedOutBatch.Merge(m, src)
+}
+func (m *TimedOutBatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *TimedOutBatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TimedOutBatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TimedOutBatch proto.InternalMessageInfo
+
+func (m *TimedOutBatch) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+func (m *TimedOutBatch) GetMinterNonce() uint64 {
+	if m != nil {
+		return m.MinterNonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OutgoingTxBatch)(nil), "minter.v1.OutgoingTxBatch")
 	proto.RegisterType((*OutgoingTransferTx)(nil), "minter.v1.OutgoingTransferTx")
+	proto.RegisterType((*TimedOutBatch)(nil), "minter.v1.TimedOutBatch")
 }
 
 func init() { proto.RegisterFile("minter/v1/batch.proto", fileDescriptor_bfc5e5d918be8aee) }
 
 var fileDescriptor_bfc5e5d918be8aee = []byte{
-	// 339 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xeb, 0xb6, 0x7f, 0x7f, 0xd5, 0xa9, 0x40, 0xb2, 0x54, 0x88, 0x40, 0x84, 0xd2, 0xa9,
-	0x53, 0xac, 0x96, 0x85, 0xb5, 0x65, 0x81, 0x01, 0x90, 0xaa, 0x4c, 0x2c, 0x95, 0x93, 0x98, 0xda,
-	0x42, 0xb1, 0xab, 0xf8, 0xb6, 0x0a, 0x6f, 0xc1, 0xc8, 0x73, 0xf0, 0x14, 0x8c, 0x1d, 0x19, 0x51,
-	0xfb, 0x22, 0xc8, 0x71, 0x28, 0x20, 0x36, 0xfb, 0xf8, 0xf3, 0x39, 0xe7, 0xea, 0xe2, 0x6e, 0x26,
-	0x15, 0xf0, 0x9c, 0xae, 0x86, 0x34, 0x66, 0x90, 0x88, 0x70, 0x91, 0x6b, 0xd0, 0xa4, 0xed, 0xe4,
-	0x70, 0x35, 0x3c, 0x3a, 0xfe, 0x26, 0x18, 0x00, 0x37, 0xc0, 0x40, 0x6a, 0xe5, 0xb8, 0xfe, 0x0b,
-	0xc2, 0xfb, 0x77, 0x4b, 0x98, 0x6b, 0xa9, 0xe6, 0x51, 0x31, 0xb1, 0x0e, 0xe4, 0x14, 0x7b, 0xa5,
-	0xd5, 0x4c, 0x69, 0x95, 0x70, 0x1f, 0xf5, 0xd0, 0xa0, 0x39, 0xc5, 0xa5, 0x74, 0x6b, 0x15, 0x72,
-	0x86, 0x3b, 0xce, 0xb3, 0x22, 0xea, 0x25, 0xe1, 0x39, 0xcd, 0x21, 0x63, 0xdc, 0x81, 0x9c, 0x29,
-	0xc3, 0x12, 0x1b, 0x66, 0xfc, 0x46, 0xaf, 0x31, 0xf0, 0x46, 0x27, 0xe1, 0xae, 0x56, 0xb8, 0x4b,
-	0xb5, 0xd8, 0x03, 0xcf, 0xa3, 0x62, 0xfa, 0xeb, 0x4b, 0xff, 0x15, 0x61, 0xf2, 0x17, 0x22, 0x7b,
-	0xb8, 0x2e, 0xd3, 0xaa, 0x54, 0x5d, 0xa6, 0xe4, 0x00, 0xb7, 0x0c, 0x57, 0x29, 0xcf, 0xcb, 0x1a,
-	0xed, 0x69, 0x75, 0xb3, 0x25, 0x53, 0x6e, 0x60, 0xc6, 0xd2, 0x34, 0xe7, 0xc6, 0x36, 0xb0, 0xaf,
-	0x9e, 0xd5, 0xc6, 0x4e, 0x22, 0x17, 0xbb, 0x39, 0x40, 0x3f, 0x72, 0xe5, 0x37, 0x7b, 0x68, 0xe0,
-	0x8d, 0xba, 0x3f, 0x4a, 0xde, 0x94, 0xa7, 0x4b, 0x2d, 0xd5, 0xd7, 0x78, 0x91, 0x25, 0xc9, 0x21,
-	0xfe, 0x0f, 0xc5, 0x4c, 0x30, 0x23, 0xfc, 0x7f, 0x2e, 0x15, 0x8a, 0x2b, 0x66, 0xc4, 0xe4, 0xfa,
-	0x6d, 0x13, 0xa0, 0xf5, 0x26, 0x40, 0x1f, 0x9b, 0x00, 0x3d, 0x6f, 0x83, 0xda, 0x7a, 0x1b, 0xd4,
-	0xde, 0xb7, 0x41, 0xed, 0x9e, 0xce, 0x25, 0x88, 0x65, 0x1c, 0x26, 0x3a, 0xa3, 0xce, 0x36, 0xe2,
-	0x2c, 0xa3, 0x99, 0x58, 0xc6, 0x34, 0x11, 0x4c, 0x2a, 0x5a, 0xd0, 0x6a, 0x53, 0xf0, 0xb4, 0xe0,
-	0x26, 0x6e, 0x95, 0x1b, 0x3a, 0xff, 0x0c, 0x00, 0x00, 0xff, 0xff, 0x64, 0xe1, 0x7e, 0x3e, 0xe2,
-	0x01, 0x00, 0x00,
+	// 365 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbd, 0x6e, 0xdb, 0x30,
+	0x14, 0x85, 0x2d, 0xff, 0x15, 0xa6, 0xdc, 0x16, 0x20, 0xea, 0x56, 0x68, 0x51, 0xd5, 0xf5, 0xe4,
+	0x49, 0x84, 0xdd, 0xa5, 0xab, 0x9d, 0x25, 0x19, 0x12, 0x03, 0x8e, 0xa6, 0x2c, 0x06, 0x25, 0x31,
+	0x16, 0xe1, 0x88, 0x34, 0xc4, 0x2b, 0x43, 0x79, 0x8b, 0x3c, 0x49, 0x86, 0x3c, 0x45, 0x46, 0x8f,
+	0x19, 0x03, 0xfb, 0x45, 0x02, 0x92, 0x8a, 0x93, 0x20, 0x6b, 0x36, 0xf1, 0xe8, 0xf0, 0x9c, 0xef,
+	0x12, 0x17, 0xf5, 0x32, 0x2e, 0x80, 0xe5, 0x64, 0x33, 0x22, 0x11, 0x85, 0x38, 0x0d, 0xd6, 0xb9,
+	0x04, 0x89, 0x3b, 0x56, 0x0e, 0x36, 0xa3, 0x9f, 0xbf, 0x5e, 0x1c, 0x14, 0x80, 0x29, 0xa0, 0xc0,
+	0xa5, 0xb0, 0xbe, 0xc1, 0xad, 0x83, 0xbe, 0xce, 0x0a, 0x58, 0x4a, 0x2e, 0x96, 0x61, 0x39, 0xd5,
+	0x09, 0xf8, 0x0f, 0x72, 0x4d, 0xd4, 0x42, 0x48, 0x11, 0x33, 0xcf, 0xe9, 0x3b, 0xc3, 0xe6, 0x1c,
+	0x19, 0xe9, 0x4c, 0x2b, 0xf8, 0x2f, 0xea, 0xda, 0xcc, 0xca, 0x51, 0x37, 0x0e, 0xd7, 0x6a, 0xd6,
+	0x32, 0x41, 0x5d, 0xc8, 0xa9, 0x50, 0x34, 0xd6, 0x65, 0xca, 0x6b, 0xf4, 0x1b, 0x43, 0x77, 0xfc,
+	0x3b, 0x38, 0x60, 0x05, 0x87, 0x56, 0x6d, 0xbb, 0x64, 0x79, 0x58, 0xce, 0xdf, 0x5c, 0xc1, 0xdf,
+	0x50, 0x2b, 0xba, 0x92, 0xf1, 0xca, 0x6b, 0x9a, 0x78, 0x7b, 0x18, 0xdc, 0x39, 0x08, 0xbf, 0xbf,
+	0x8a, 0xbf, 0xa0, 0x3a, 0x4f, 0x2a, 0xd4, 0x3a, 0x4f, 0xf0, 0x77, 0xd4, 0x56, 0x4c, 0x24, 0x2c,
+	0x37, 0x70, 0x9d, 0x79, 0x75, 0xd2, 0xe8, 0x09, 0x53, 0xb0, 0xa0, 0x49, 0x92, 0x33, 0xa5, 0xb9,
+	0xf4, 0x5f, 0x57, 0x6b, 0x13, 0x2b, 0xe1, 0xff, 0x87, 0xe9, 0x40, 0xae, 0x98, 0x30, 0xf5, 0xee,
+	0xb8, 0xf7, 0x0a, 0xfd, 0xd4, 0x7c, 0x1d, 0x49, 0x2e, 0x9e, 0x87, 0x0e, 0xb5, 0x13, 0xff, 0x40,
+	0x9f, 0xa0, 0x5c, 0xa4, 0x54, 0xa5, 0x5e, 0xcb, 0xb6, 0x42, 0x79, 0x4c, 0x55, 0x3a, 0x38, 0x47,
+	0x9f, 0x43, 0x9e, 0xb1, 0x64, 0x56, 0xc0, 0x87, 0x3d, 0xf1, 0xf4, 0xe4, 0x7e, 0xe7, 0x3b, 0xdb,
+	0x9d, 0xef, 0x3c, 0xee, 0x7c, 0xe7, 0x66, 0xef, 0xd7, 0xb6, 0x7b, 0xbf, 0xf6, 0xb0, 0xf7, 0x6b,
+	0x17, 0x64, 0xc9, 0x21, 0x2d, 0xa2, 0x20, 0x96, 0x19, 0xb1, 0xac, 0x21, 0xa3, 0x19, 0xc9, 0xd2,
+	0x22, 0x22, 0x71, 0x4a, 0xb9, 0x20, 0x25, 0xa9, 0x96, 0x02, 0xae, 0xd7, 0x4c, 0x45, 0x6d, 0xb3,
+	0x0c, 0xff, 0x9e, 0x06, 0x00, 0x6b, 0x59, 0xdc, 0xcb, 0x4d, 0x02, 0x00, 0x00,
 }
 
 func (m *OutgoingTxBatch) Marshal() (dAtA []byte, err error) {
@@ -211,6 +275,11 @@ func (m *OutgoingTxBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Block != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Transactions) > 0 {
 		for iNdEx := len(m.Transactions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -299,6 +368,39 @@ func (m *OutgoingTransferTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TimedOutBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TimedOutBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TimedOutBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinterNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.MinterNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintBatch(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBatch(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBatch(v)
 	base := offset
@@ -328,6 +430,9 @@ func (m *OutgoingTxBatch) Size() (n int) {
 			n += 1 + l + sovBatch(uint64(l))
 		}
 	}
+	if m.Block != 0 {
+		n += 1 + sovBatch(uint64(m.Block))
+	}
 	return n
 }
 
@@ -359,6 +464,21 @@ func (m *OutgoingTransferTx) Size() (n int) {
 	return n
 }
 
+func (m *TimedOutBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BatchNonce != 0 {
+		n += 1 + sovBatch(uint64(m.BatchNonce))
+	}
+	if m.MinterNonce != 0 {
+		n += 1 + sovBatch(uint64(m.MinterNonce))
+	}
+	return n
+}
+
 func sovBatch(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -466,6 +586,25 @@ func (m *OutgoingTxBatch) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBatch(dAtA[iNdEx:])
@@ -694,6 +833,97 @@ func (m *OutgoingTransferTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TimedOutBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatch
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TimedOutBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TimedOutBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterNonce", wireType)
+			}
+			m.MinterNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatch
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinterNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatch(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthBatch
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBatch(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -6,12 +6,14 @@ const (
 	EventTypeMultisigBootstrap        = "minter_multisig_bootstrap"
 	EventTypeMultisigUpdateRequest    = "minter_multisig_update_request"
 	EventTypeOutgoingBatchCanceled    = "minter_outgoing_batch_canceled"
+	EventTypeOutgoingBatchTimeout     = "minter_outgoing_batch_timeout"
 	EventTypeBridgeWithdrawalReceived = "minter_withdrawal_received"
 	EventTypeBridgeDepositReceived    = "minter_deposit_received"
 	EventTypeRefund                   = "minter_refund"
 	EventTypeWithdrawRequest          = "minter_withdraw"
 	EventTypeOutgoingBatchExecuted    = "minter_batch_executed"
 	EventTypeProcessAttestation       = "minter_process_attestation"
+	EventTypeSlash                    = "minter_slash"
 
 	AttributeKeyAttestationID   = "attestation_id"
 	AttributeKeyMultisigID      = "multisig_id"
@@ -20,6 +22,9 @@ const (
 	AttributeKeyAttestationType = "attestation_type"
 	AttributeKeyContract        = "bridge_contract"
 	AttributeKeyNonce           = "nonce"
+	AttributeKeyMinterNonce     = "minter_nonce"
 	AttributeKeyBridgeChainID   = "bridge_chain_id"
 	AttributeKeyTxHash          = "tx_hash"
+	AttributeKeyValidator       = "validator"
+	AttributeKeyReason          = "reason"
 )
@@ -11,6 +11,8 @@ type StakingKeeper interface {
 	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
 	GetLastTotalPower(ctx sdk.Context) (power sdk.Int)
 	Jail(sdk.Context, sdk.ConsAddress)
+	Slash(sdk.Context, sdk.ConsAddress, int64, int64, sdk.Dec)
+	Validator(sdk.Context, sdk.ValAddress) stakingtypes.ValidatorI
 }
 
 // BankKeeper defines the expected bank keeper methods
@@ -16,6 +16,10 @@ const (
 	// todo: implement oracle constants as params
 	DefaultParamspace = ModuleName
 	AttestationPeriod = 24 * time.Hour // TODO: value????
+
+	// MinterMainnetChainID and MinterTestnetChainID are the chain ids of the Minter networks
+	MinterMainnetChainID = 1
+	MinterTestnetChainID = 2
 )
 
 var (
@@ -34,6 +38,9 @@ var (
 	// ParamsStoreKeyBridgeContractChainID stores the bridge chain id
 	ParamsStoreKeyBridgeContractChainID = []byte("BridgeChainID")
 
+	// ParamsStoreKeyMinterChainID stores the chain id of the Minter network the multisig txs are signed for
+	ParamsStoreKeyMinterChainID = []byte("MinterChainID")
+
 	// ParamsStoreKeySignedValsetsWindow stores the signed blocks window
 	ParamsStoreKeySignedValsetsWindow = []byte("SignedValsetsWindow")
 
@@ -57,6 +64,9 @@ var (
 
 	ParamsStopped = []byte("Stopped")
 
+	// ParamsStoreKeyBatchTimeoutBlocks stores the number of blocks after which an unexecuted batch is cancelled
+	ParamsStoreKeyBatchTimeoutBlocks = []byte("BatchTimeoutBlocks")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -90,6 +100,8 @@ func DefaultParams() *Params {
 		SlashFractionClaim:            sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		SlashFractionConflictingClaim: sdk.NewDec(1).Quo(sdk.NewDec(1000)),
 		Stopped:                       false,
+		BatchTimeoutBlocks:            5000,
+		MinterChainId:                 MinterMainnetChainID,
 	}
 }
 
@@ -105,6 +117,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateBridgeChainID(p.BridgeChainId); err != nil {
 		return sdkerrors.Wrap(err, "bridge chain id")
 	}
+	if err := validateMinterChainID(p.MinterChainId); err != nil {
+		return sdkerrors.Wrap(err, "minter chain id")
+	}
 	return nil
 }
 
@@ -128,6 +143,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionClaim, &p.SlashFractionClaim, validateSlashFractionClaim),
 		paramtypes.NewParamSetPair(ParamsStoreSlashFractionConflictingClaim, &p.SlashFractionConflictingClaim, validateSlashFractionConflictingClaim),
 		paramtypes.NewParamSetPair(ParamsStopped, &p.Stopped, validateStopped),
+		paramtypes.NewParamSetPair(ParamsStoreKeyBatchTimeoutBlocks, &p.BatchTimeoutBlocks, validateBatchTimeoutBlocks),
+		paramtypes.NewParamSetPair(ParamsStoreKeyMinterChainID, &p.MinterChainId, validateMinterChainID),
 	}
 }
 
@@ -255,3 +272,22 @@ func validateStopped(i interface{}) error {
 	}
 	return nil
 }
+
+func validateBatchTimeoutBlocks(i interface{}) error {
+	// zero disables the timeout
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMinterChainID(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v == 0 {
+		return fmt.Errorf("minter chain id cannot be 0")
+	}
+	return nil
+}
@@ -36,6 +36,8 @@ type Params struct {
 	SlashFractionClaim            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=slash_fraction_claim,json=slashFractionClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_claim"`
 	SlashFractionConflictingClaim github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=slash_fraction_conflicting_claim,json=slashFractionConflictingClaim,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction_conflicting_claim"`
 	Stopped                       bool                                   `protobuf:"varint,11,opt,name=stopped,proto3" json:"stopped,omitempty"`
+	BatchTimeoutBlocks            uint64                                 `protobuf:"varint,12,opt,name=batch_timeout_blocks,json=batchTimeoutBlocks,proto3" json:"batch_timeout_blocks,omitempty"`
+	MinterChainId                 uint64                                 `protobuf:"varint,13,opt,name=minter_chain_id,json=minterChainId,proto3" json:"minter_chain_id,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -120,10 +122,42 @@ func (m *Params) GetStopped() bool {
 	return false
 }
 
+func (m *Params) GetBatchTimeoutBlocks() uint64 {
+	if m != nil {
+		return m.BatchTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinterChainId() uint64 {
+	if m != nil {
+		return m.MinterChainId
+	}
+	return 0
+}
+
 // GenesisState struct
 type GenesisState struct {
-	Params           *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	StartMinterNonce uint64  `protobuf:"varint,2,opt,name=start_minter_nonce,json=startMinterNonce,proto3" json:"start_minter_nonce,omitempty"`
+	Params                 *Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	StartMinterNonce       uint64              `protobuf:"varint,2,opt,name=start_minter_nonce,json=startMinterNonce,proto3" json:"start_minter_nonce,omitempty"`
+	Valsets                []*Valset           `protobuf:"bytes,3,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms         []*MsgValsetConfirm `protobuf:"bytes,4,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	LastValset             *Valset             `protobuf:"bytes,5,opt,name=last_valset,json=lastValset,proto3" json:"last_valset,omitempty"`
+	OutgoingTxs            []OutgoingTxEntry   `protobuf:"bytes,6,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs"`
+	UnbatchedTxIds         []uint64            `protobuf:"varint,7,rep,packed,name=unbatched_tx_ids,json=unbatchedTxIds,proto3" json:"unbatched_tx_ids,omitempty"`
+	Batches                []*OutgoingTxBatch  `protobuf:"bytes,8,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms          []*MsgConfirmBatch  `protobuf:"bytes,9,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms,omitempty"`
+	Attestations           []AttestationEntry  `protobuf:"bytes,10,rep,name=attestations,proto3" json:"attestations"`
+	LastObservedEventNonce uint64              `protobuf:"varint,11,opt,name=last_observed_event_nonce,json=lastObservedEventNonce,proto3" json:"last_observed_event_nonce,omitempty"`
+	LastEventNonces        []LastEventNonce    `protobuf:"bytes,12,rep,name=last_event_nonces,json=lastEventNonces,proto3" json:"last_event_nonces"`
+	MinterAddresses        []MinterAddress     `protobuf:"bytes,13,rep,name=minter_addresses,json=minterAddresses,proto3" json:"minter_addresses"`
+	StartTxPoolId          uint64              `protobuf:"varint,14,opt,name=start_tx_pool_id,json=startTxPoolId,proto3" json:"start_tx_pool_id,omitempty"`
+	StartBatchNonce        uint64              `protobuf:"varint,15,opt,name=start_batch_nonce,json=startBatchNonce,proto3" json:"start_batch_nonce,omitempty"`
+	ValsetSigningChecks    []SigningCheck      `protobuf:"bytes,16,rep,name=valset_signing_checks,json=valsetSigningChecks,proto3" json:"valset_signing_checks"`
+	BatchSigningChecks     []SigningCheck      `protobuf:"bytes,17,rep,name=batch_signing_checks,json=batchSigningChecks,proto3" json:"batch_signing_checks"`
+	VoucherAccounting      VoucherAccounting   `protobuf:"bytes,18,opt,name=voucher_accounting,json=voucherAccounting,proto3" json:"voucher_accounting"`
+	TimedOutBatches        []TimedOutBatch     `protobuf:"bytes,19,rep,name=timed_out_batches,json=timedOutBatches,proto3" json:"timed_out_batches"`
+	Claims                 []ClaimEntry        `protobuf:"bytes,20,rep,name=claims,proto3" json:"claims"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -173,267 +207,2433 @@ func (m *GenesisState) GetStartMinterNonce() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "minter.v1.Params")
-	proto.RegisterType((*GenesisState)(nil), "minter.v1.GenesisState")
+func (m *GenesisState) GetValsets() []*Valset {
+	if m != nil {
+		return m.Valsets
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("minter/v1/genesis.proto", fileDescriptor_43fc00fc33749c12) }
-
-var fileDescriptor_43fc00fc33749c12 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x14, 0x8c, 0x21, 0x4d, 0x9b, 0xed, 0x17, 0x6c, 0x53, 0x61, 0x21, 0xe1, 0x5a, 0x95, 0x28, 0x41,
-	0x02, 0x2f, 0x2d, 0x37, 0x6e, 0xa4, 0x08, 0xd4, 0x03, 0x08, 0x99, 0x0a, 0x24, 0x2e, 0x66, 0xed,
-	0xdd, 0xda, 0x2b, 0xec, 0x5d, 0xcb, 0xbb, 0x4d, 0xe0, 0xc6, 0x4f, 0xe0, 0x67, 0xf5, 0xd8, 0x23,
-	0x02, 0x54, 0xa1, 0xe4, 0x8f, 0xa0, 0xbc, 0xdd, 0x86, 0xb4, 0xe2, 0x54, 0xf5, 0x94, 0x78, 0xe6,
-	0xcd, 0x1b, 0x8f, 0xf5, 0x06, 0xdd, 0xa9, 0x84, 0x34, 0xbc, 0x21, 0xc3, 0x5d, 0x92, 0x73, 0xc9,
-	0xb5, 0xd0, 0x51, 0xdd, 0x28, 0xa3, 0x70, 0xd7, 0x12, 0xd1, 0x70, 0xf7, 0x6e, 0x2f, 0x57, 0xb9,
-	0x02, 0x94, 0x4c, 0xff, 0xd9, 0x81, 0xed, 0x5f, 0x0b, 0xa8, 0xf3, 0x96, 0x36, 0xb4, 0xd2, 0xf8,
-	0x01, 0x5a, 0xd7, 0x86, 0x36, 0x26, 0x31, 0x45, 0xc3, 0x75, 0xa1, 0x4a, 0xe6, 0x7b, 0xa1, 0xd7,
-	0x6f, 0xc7, 0x6b, 0x00, 0x1f, 0x9e, 0xa3, 0xf8, 0x3e, 0x5a, 0xb3, 0x6b, 0x13, 0xca, 0x58, 0xc3,
-	0xb5, 0xf6, 0x6f, 0x84, 0x5e, 0xbf, 0x1b, 0xaf, 0x5a, 0xf4, 0xb9, 0x05, 0xf1, 0x0e, 0x5a, 0x4f,
-	0x1b, 0xc1, 0x72, 0x9e, 0x64, 0x05, 0x15, 0x32, 0x11, 0xcc, 0xbf, 0x09, 0xfb, 0x56, 0x2d, 0xbc,
-	0x3f, 0x45, 0x0f, 0x18, 0xde, 0x43, 0x9b, 0x5a, 0xe4, 0x92, 0xb3, 0x64, 0x48, 0x4b, 0xcd, 0x8d,
-	0x4e, 0x46, 0x42, 0x32, 0x35, 0xf2, 0xdb, 0x30, 0xbd, 0x61, 0xc9, 0xf7, 0x96, 0xfb, 0x00, 0xd4,
-	0x9c, 0x26, 0xa5, 0x26, 0x2b, 0xf8, 0x4c, 0xb3, 0x30, 0xaf, 0x19, 0x58, 0xce, 0x69, 0x9e, 0xa0,
-	0x9e, 0xd3, 0x64, 0x25, 0x15, 0xd5, 0x4c, 0xd2, 0x01, 0x09, 0xb6, 0xdc, 0x3e, 0x50, 0x4e, 0x91,
-	0xa2, 0x4d, 0x5d, 0x52, 0x5d, 0x24, 0x47, 0x0d, 0xcd, 0x8c, 0x50, 0xd2, 0xbd, 0xa1, 0xbf, 0x18,
-	0x7a, 0xfd, 0x95, 0x41, 0x74, 0x72, 0xb6, 0xd5, 0xfa, 0x79, 0xb6, 0xb5, 0x93, 0x0b, 0x53, 0x1c,
-	0xa7, 0x51, 0xa6, 0x2a, 0x92, 0x29, 0x5d, 0x29, 0xed, 0x7e, 0x1e, 0x6b, 0xf6, 0x99, 0x98, 0xaf,
-	0x35, 0xd7, 0xd1, 0x0b, 0x9e, 0xc5, 0x1b, 0xb0, 0xec, 0xa5, 0xdb, 0x65, 0x03, 0xe1, 0x4f, 0xa8,
-	0x77, 0xc9, 0x03, 0x12, 0xf9, 0x4b, 0x57, 0xb2, 0xc0, 0x17, 0x2c, 0x20, 0xff, 0x7f, 0x1c, 0x20,
-	0xbf, 0xdf, 0xbd, 0x06, 0x07, 0xf8, 0x5c, 0x78, 0x84, 0xc2, 0xcb, 0x0e, 0x4a, 0x1e, 0x95, 0x22,
-	0x33, 0x42, 0xe6, 0xce, 0x0d, 0x5d, 0xc9, 0xed, 0xde, 0x45, 0xb7, 0x7f, 0x5b, 0xad, 0xb1, 0x8f,
-	0x16, 0xb5, 0x51, 0x75, 0xcd, 0x99, 0xbf, 0x1c, 0x7a, 0xfd, 0xa5, 0xf8, 0xfc, 0xf1, 0x59, 0xfb,
-	0xdb, 0xef, 0xb0, 0xb5, 0x9d, 0xa3, 0x95, 0x57, 0xb6, 0x0f, 0xef, 0x0c, 0x35, 0x1c, 0x3f, 0x44,
-	0x9d, 0x1a, 0x8e, 0x1d, 0x2e, 0x7b, 0x79, 0xef, 0x76, 0x34, 0xeb, 0x47, 0x64, 0x5b, 0x10, 0xbb,
-	0x01, 0xfc, 0x08, 0x61, 0xdb, 0x06, 0x77, 0xea, 0x52, 0xc9, 0x8c, 0xc3, 0xa1, 0xb7, 0xe3, 0x5b,
-	0xc0, 0xbc, 0x06, 0xe2, 0xcd, 0x14, 0x1f, 0x1c, 0x9c, 0x8c, 0x03, 0xef, 0x74, 0x1c, 0x78, 0x7f,
-	0xc6, 0x81, 0xf7, 0x7d, 0x12, 0xb4, 0x4e, 0x27, 0x41, 0xeb, 0xc7, 0x24, 0x68, 0x7d, 0x24, 0x73,
-	0x49, 0xad, 0xe2, 0x90, 0xd3, 0x8a, 0x54, 0xc5, 0x71, 0x4a, 0xa0, 0x17, 0xe4, 0x0b, 0x71, 0xed,
-	0x85, 0xd8, 0x69, 0x07, 0x8a, 0xf9, 0xf4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee, 0x5c, 0xb7,
-	0x47, 0xd4, 0x03, 0x00, 0x00,
+func (m *GenesisState) GetValsetConfirms() []*MsgValsetConfirm {
+	if m != nil {
+		return m.ValsetConfirms
+	}
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetLastValset() *Valset {
+	if m != nil {
+		return m.LastValset
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetOutgoingTxs() []OutgoingTxEntry {
+	if m != nil {
+		return m.OutgoingTxs
+	}
+	return nil
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Stopped {
-		i--
-		if m.Stopped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
-	}
-	{
-		size := m.SlashFractionConflictingClaim.Size()
-		i -= size
-		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.SlashFractionClaim.Size()
-		i -= size
-		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	{
-		size := m.SlashFractionBatch.Size()
-		i -= size
-		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.SlashFractionValset.Size()
-		i -= size
-		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.SignedClaimsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.SignedBatchesWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.SignedValsetsWindow != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.MinterAddress) > 0 {
-		i -= len(m.MinterAddress)
-		copy(dAtA[i:], m.MinterAddress)
-		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MinterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.StartThreshold != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.StartThreshold))
-		i--
-		dAtA[i] = 0x8
+func (m *GenesisState) GetUnbatchedTxIds() []uint64 {
+	if m != nil {
+		return m.UnbatchedTxIds
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *GenesisState) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GenesisState) GetBatches() []*OutgoingTxBatch {
+	if m != nil {
+		return m.Batches
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GenesisState) GetBatchConfirms() []*MsgConfirmBatch {
+	if m != nil {
+		return m.BatchConfirms
+	}
+	return nil
 }
 
-func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StartMinterNonce != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.StartMinterNonce))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenesis(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *GenesisState) GetAttestations() []AttestationEntry {
+	if m != nil {
+		return m.Attestations
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GenesisState) GetLastObservedEventNonce() uint64 {
+	if m != nil {
+		return m.LastObservedEventNonce
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.StartThreshold != 0 {
-		n += 1 + sovGenesis(uint64(m.StartThreshold))
-	}
-	l = len(m.MinterAddress)
-	if l > 0 {
-		n += 1 + l + sovGenesis(uint64(l))
+
+func (m *GenesisState) GetLastEventNonces() []LastEventNonce {
+	if m != nil {
+		return m.LastEventNonces
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	return nil
+}
+
+func (m *GenesisState) GetMinterAddresses() []MinterAddress {
+	if m != nil {
+		return m.MinterAddresses
 	}
-	if m.SignedValsetsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	return nil
+}
+
+func (m *GenesisState) GetStartTxPoolId() uint64 {
+	if m != nil {
+		return m.StartTxPoolId
 	}
-	if m.SignedBatchesWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	return 0
+}
+
+func (m *GenesisState) GetStartBatchNonce() uint64 {
+	if m != nil {
+		return m.StartBatchNonce
 	}
-	if m.SignedClaimsWindow != 0 {
-		n += 1 + sovGenesis(uint64(m.SignedClaimsWindow))
+	return 0
+}
+
+func (m *GenesisState) GetValsetSigningChecks() []SigningCheck {
+	if m != nil {
+		return m.ValsetSigningChecks
 	}
-	l = m.SlashFractionValset.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionBatch.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionClaim.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	l = m.SlashFractionConflictingClaim.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if m.Stopped {
-		n += 2
+	return nil
+}
+
+func (m *GenesisState) GetBatchSigningChecks() []SigningCheck {
+	if m != nil {
+		return m.BatchSigningChecks
 	}
-	return n
+	return nil
 }
 
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GenesisState) GetVoucherAccounting() VoucherAccounting {
+	if m != nil {
+		return m.VoucherAccounting
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovGenesis(uint64(l))
+	return VoucherAccounting{}
+}
+
+func (m *GenesisState) GetTimedOutBatches() []TimedOutBatch {
+	if m != nil {
+		return m.TimedOutBatches
 	}
-	if m.StartMinterNonce != 0 {
-		n += 1 + sovGenesis(uint64(m.StartMinterNonce))
+	return nil
+}
+
+func (m *GenesisState) GetClaims() []ClaimEntry {
+	if m != nil {
+		return m.Claims
 	}
-	return n
+	return nil
 }
 
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+// OutgoingTxEntry is a transfer in the outgoing pool under its id
+type OutgoingTxEntry struct {
+	Id uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tx OutgoingTx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
 }
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *OutgoingTxEntry) Reset()         { *m = OutgoingTxEntry{} }
+func (m *OutgoingTxEntry) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxEntry) ProtoMessage()    {}
+func (*OutgoingTxEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43fc00fc33749c12, []int{2}
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *OutgoingTxEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTxEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxEntry.Merge(m, src)
+}
+func (m *OutgoingTxEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTxEntry proto.InternalMessageInfo
+
+func (m *OutgoingTxEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OutgoingTxEntry) GetTx() OutgoingTx {
+	if m != nil {
+		return m.Tx
+	}
+	return OutgoingTx{}
+}
+
+// AttestationEntry is an attestation with the hash of the claim it is stored under
+type AttestationEntry struct {
+	ClaimHash   []byte      `protobuf:"bytes,1,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	Attestation Attestation `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation"`
+}
+
+func (m *AttestationEntry) Reset()         { *m = AttestationEntry{} }
+func (m *AttestationEntry) String() string { return proto.CompactTextString(m) }
+func (*AttestationEntry) ProtoMessage()    {}
+func (*AttestationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43fc00fc33749c12, []int{3}
+}
+func (m *AttestationEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationEntry.Merge(m, src)
+}
+func (m *AttestationEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationEntry proto.InternalMessageInfo
+
+func (m *AttestationEntry) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+func (m *AttestationEntry) GetAttestation() Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return Attestation{}
+}
+
+// ClaimEntry is the record of a claim submitted by the validator for the event
+type ClaimEntry struct {
+	ClaimType  ClaimType `protobuf:"varint,1,opt,name=claim_type,json=claimType,proto3,enum=minter.v1.ClaimType" json:"claim_type,omitempty"`
+	EventNonce uint64    `protobuf:"varint,2,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	Validator  string    `protobuf:"bytes,3,opt,name=validator,proto3" json:"validator,omitempty"`
+	ClaimHash  []byte    `protobuf:"bytes,4,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+}
+
+func (m *ClaimEntry) Reset()         { *m = ClaimEntry{} }
+func (m *ClaimEntry) String() string { return proto.CompactTextString(m) }
+func (*ClaimEntry) ProtoMessage()    {}
+func (*ClaimEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43fc00fc33749c12, []int{4}
+}
+func (m *ClaimEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimEntry.Merge(m, src)
+}
+func (m *ClaimEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimEntry proto.InternalMessageInfo
+
+func (m *ClaimEntry) GetClaimType() ClaimType {
+	if m != nil {
+		return m.ClaimType
+	}
+	return CLAIM_TYPE_UNKNOWN
+}
+
+func (m *ClaimEntry) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *ClaimEntry) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *ClaimEntry) GetClaimHash() []byte {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return nil
+}
+
+// LastEventNonce is the nonce of the last event claimed by the validator
+type LastEventNonce struct {
+	Validator string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	Nonce     uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *LastEventNonce) Reset()         { *m = LastEventNonce{} }
+func (m *LastEventNonce) String() string { return proto.CompactTextString(m) }
+func (*LastEventNonce) ProtoMessage()    {}
+func (*LastEventNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43fc00fc33749c12, []int{5}
+}
+func (m *LastEventNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LastEventNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LastEventNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LastEventNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LastEventNonce.Merge(m, src)
+}
+func (m *LastEventNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *LastEventNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_LastEventNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LastEventNonce proto.InternalMessageInfo
+
+func (m *LastEventNonce) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *LastEventNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// MinterAddress is the Minter address registered for the validator account
+type MinterAddress struct {
+	Validator     string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	MinterAddress string `protobuf:"bytes,2,opt,name=minter_address,json=minterAddress,proto3" json:"minter_address,omitempty"`
+}
+
+func (m *MinterAddress) Reset()         { *m = MinterAddress{} }
+func (m *MinterAddress) String() string { return proto.CompactTextString(m) }
+func (*MinterAddress) ProtoMessage()    {}
+func (*MinterAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43fc00fc33749c12, []int{6}
+}
+func (m *MinterAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterAddress.Merge(m, src)
+}
+func (m *MinterAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterAddress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterAddress proto.InternalMessageInfo
+
+func (m *MinterAddress) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *MinterAddress) GetMinterAddress() string {
+	if m != nil {
+		return m.MinterAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "minter.v1.Params")
+	proto.RegisterType((*GenesisState)(nil), "minter.v1.GenesisState")
+	proto.RegisterType((*OutgoingTxEntry)(nil), "minter.v1.OutgoingTxEntry")
+	proto.RegisterType((*AttestationEntry)(nil), "minter.v1.AttestationEntry")
+	proto.RegisterType((*ClaimEntry)(nil), "minter.v1.ClaimEntry")
+	proto.RegisterType((*LastEventNonce)(nil), "minter.v1.LastEventNonce")
+	proto.RegisterType((*MinterAddress)(nil), "minter.v1.MinterAddress")
+}
+
+func init() { proto.RegisterFile("minter/v1/genesis.proto", fileDescriptor_43fc00fc33749c12) }
+
+var fileDescriptor_43fc00fc33749c12 = []byte{
+	// 1155 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdd, 0x6e, 0x1b, 0xd5,
+	0x13, 0x8f, 0x13, 0xc7, 0x89, 0xc7, 0xdf, 0x27, 0x4e, 0xbb, 0x4d, 0x5b, 0xc7, 0xb2, 0xf4, 0xef,
+	0x3f, 0x50, 0xb0, 0x69, 0xc2, 0x0d, 0x5c, 0x20, 0xe5, 0x0b, 0x08, 0x90, 0xa6, 0xdd, 0x5a, 0x20,
+	0x71, 0xb3, 0xac, 0x77, 0x4f, 0xbd, 0xab, 0xda, 0x7b, 0xcc, 0x9e, 0x63, 0xc7, 0xb9, 0xe3, 0x11,
+	0x78, 0x03, 0x9e, 0x82, 0x77, 0xe8, 0x65, 0x2f, 0x11, 0x82, 0x0a, 0x25, 0x2f, 0x82, 0xce, 0xcc,
+	0xb1, 0xbd, 0xb6, 0x2b, 0xa8, 0x2a, 0xae, 0xbc, 0x9e, 0x99, 0xdf, 0xcc, 0x99, 0x39, 0xf3, 0x9b,
+	0x39, 0x70, 0xbb, 0x1f, 0x46, 0x8a, 0xc7, 0xad, 0xd1, 0xa3, 0x56, 0x97, 0x47, 0x5c, 0x86, 0xb2,
+	0x39, 0x88, 0x85, 0x12, 0x2c, 0x4b, 0x8a, 0xe6, 0xe8, 0xd1, 0x4e, 0xb5, 0x2b, 0xba, 0x02, 0xa5,
+	0x2d, 0xfd, 0x45, 0x06, 0x3b, 0xdb, 0x33, 0xa4, 0xba, 0x1a, 0x70, 0x83, 0xdb, 0xa9, 0xce, 0xc4,
+	0x7d, 0xd9, 0x95, 0xcb, 0xc6, 0x1d, 0x57, 0x79, 0xc1, 0xb2, 0xf1, 0x40, 0x88, 0x9e, 0x91, 0xde,
+	0x9d, 0x49, 0x5d, 0xa5, 0xb8, 0x54, 0xae, 0x0a, 0x45, 0x44, 0xca, 0xc6, 0xaf, 0x19, 0xc8, 0x3c,
+	0x71, 0x63, 0xb7, 0x2f, 0xd9, 0xff, 0xa1, 0x24, 0x95, 0x1b, 0x2b, 0x47, 0x05, 0x31, 0x97, 0x81,
+	0xe8, 0xf9, 0x56, 0xaa, 0x9e, 0xda, 0x4b, 0xdb, 0x45, 0x14, 0xb7, 0x27, 0x52, 0xf6, 0x3f, 0x28,
+	0x92, 0x4b, 0xc7, 0xf5, 0xfd, 0x98, 0x4b, 0x69, 0xad, 0xd6, 0x53, 0x7b, 0x59, 0xbb, 0x40, 0xd2,
+	0x43, 0x12, 0xb2, 0x07, 0x50, 0xea, 0xc4, 0xa1, 0xdf, 0xe5, 0x8e, 0x17, 0xb8, 0x61, 0xe4, 0x84,
+	0xbe, 0xb5, 0x86, 0xfe, 0x0a, 0x24, 0x3e, 0xd6, 0xd2, 0x33, 0x9f, 0xed, 0xc3, 0xb6, 0x0c, 0xbb,
+	0x11, 0xf7, 0x9d, 0x91, 0xdb, 0x93, 0x5c, 0x49, 0xe7, 0x32, 0x8c, 0x7c, 0x71, 0x69, 0xa5, 0xd1,
+	0x7a, 0x8b, 0x94, 0xdf, 0x92, 0xee, 0x3b, 0x54, 0x25, 0x30, 0x98, 0x3f, 0x9f, 0x62, 0xd6, 0x93,
+	0x98, 0x23, 0xd2, 0x19, 0xcc, 0x47, 0x50, 0x35, 0x18, 0xaf, 0xe7, 0x86, 0xfd, 0x29, 0x24, 0x83,
+	0x10, 0x46, 0xba, 0x63, 0x54, 0x19, 0x44, 0x07, 0xb6, 0x65, 0xcf, 0x95, 0x81, 0xf3, 0x3c, 0x76,
+	0x3d, 0x5d, 0x34, 0x73, 0x42, 0x6b, 0xa3, 0x9e, 0xda, 0xcb, 0x1f, 0x35, 0x5f, 0xbe, 0xde, 0x5d,
+	0xf9, 0xfd, 0xf5, 0xee, 0x83, 0x6e, 0xa8, 0x82, 0x61, 0xa7, 0xe9, 0x89, 0x7e, 0xcb, 0x13, 0xb2,
+	0x2f, 0xa4, 0xf9, 0xf9, 0x50, 0xfa, 0x2f, 0xcc, 0x6d, 0x9e, 0x70, 0xcf, 0xde, 0x42, 0x67, 0x9f,
+	0x1b, 0x5f, 0x94, 0x10, 0xfb, 0x01, 0xaa, 0x0b, 0x31, 0x30, 0x23, 0x6b, 0xf3, 0x9d, 0x42, 0xb0,
+	0xb9, 0x10, 0x98, 0xff, 0x1b, 0x22, 0x60, 0xfe, 0x56, 0xf6, 0x3f, 0x88, 0x80, 0xe5, 0x62, 0x97,
+	0x50, 0x5f, 0x8c, 0x20, 0xa2, 0xe7, 0xbd, 0xd0, 0x53, 0x61, 0xd4, 0x35, 0xd1, 0xe0, 0x9d, 0xa2,
+	0xdd, 0x9f, 0x8f, 0x36, 0xf3, 0x4a, 0x81, 0x2d, 0xd8, 0x90, 0x4a, 0x0c, 0x06, 0xdc, 0xb7, 0x72,
+	0xf5, 0xd4, 0xde, 0xa6, 0x3d, 0xf9, 0xab, 0x2f, 0x1b, 0xeb, 0xe8, 0xa8, 0xb0, 0xcf, 0xc5, 0x50,
+	0x39, 0x9d, 0x9e, 0xf0, 0x5e, 0x48, 0x2b, 0x4f, 0x97, 0x8d, 0xba, 0x36, 0xa9, 0x8e, 0x50, 0xa3,
+	0xdb, 0xd5, 0x74, 0xf5, 0xb4, 0x5d, 0x0b, 0xd4, 0xae, 0x24, 0x36, 0xed, 0xfa, 0x69, 0xfa, 0xa7,
+	0x3f, 0xea, 0x2b, 0x8d, 0x3f, 0xb3, 0x90, 0xff, 0x82, 0x18, 0xfe, 0x4c, 0xb9, 0x8a, 0xb3, 0xf7,
+	0x20, 0x33, 0x40, 0x1e, 0x21, 0x69, 0x72, 0xfb, 0x95, 0xe6, 0x94, 0xf1, 0x4d, 0x22, 0x98, 0x6d,
+	0x0c, 0xd8, 0x07, 0xc0, 0x88, 0x68, 0x26, 0x5e, 0x24, 0x22, 0x8f, 0x23, 0x87, 0xd2, 0x76, 0x19,
+	0x35, 0xe7, 0xa8, 0x78, 0xac, 0xe5, 0xec, 0x21, 0x6c, 0x18, 0x5e, 0x58, 0x6b, 0xf5, 0xb5, 0x05,
+	0xcf, 0xd4, 0x44, 0xf6, 0xc4, 0x82, 0x9d, 0x40, 0x89, 0x3e, 0xf1, 0x06, 0xc2, 0xb8, 0x2f, 0xad,
+	0x34, 0x82, 0xee, 0x26, 0x40, 0xe7, 0xb2, 0x4b, 0xb8, 0x63, 0xb2, 0xb1, 0x8b, 0xa3, 0xe4, 0x5f,
+	0xc9, 0xf6, 0x21, 0xd7, 0x73, 0xa5, 0x9a, 0x74, 0xfb, 0xfa, 0x52, 0x42, 0x26, 0x2c, 0x68, 0x2b,
+	0xfa, 0x66, 0xc7, 0x90, 0x17, 0x43, 0xd5, 0x15, 0xfa, 0xc6, 0xd5, 0x58, 0x5a, 0x19, 0x0c, 0xbb,
+	0x93, 0x00, 0x5d, 0x18, 0x75, 0x7b, 0x7c, 0x1a, 0xa9, 0xf8, 0xea, 0x28, 0xad, 0x7b, 0xc1, 0xce,
+	0x89, 0xa9, 0x58, 0xb2, 0x3d, 0x28, 0x0f, 0x23, 0x62, 0xb4, 0xef, 0xa8, 0xb1, 0x13, 0xfa, 0xd2,
+	0xda, 0xa8, 0xaf, 0xe9, 0x19, 0x34, 0x95, 0xb7, 0xc7, 0x67, 0xbe, 0x64, 0x1f, 0xc3, 0x06, 0xfd,
+	0x97, 0xd6, 0xe6, 0x3f, 0x44, 0x42, 0x06, 0xd8, 0x13, 0x53, 0x76, 0x08, 0x45, 0xfc, 0x9c, 0x55,
+	0x27, 0xbb, 0x04, 0x3e, 0x97, 0x5d, 0x53, 0x08, 0x02, 0x17, 0x10, 0x31, 0xad, 0xcd, 0x29, 0xe4,
+	0x13, 0x53, 0x54, 0x5a, 0xb0, 0x54, 0xde, 0xc3, 0x99, 0x3a, 0x99, 0xe8, 0x1c, 0x8c, 0x7d, 0x02,
+	0x77, 0xb0, 0xc4, 0xa2, 0x23, 0x79, 0x3c, 0xe2, 0xbe, 0xc3, 0x47, 0x3c, 0x52, 0xa6, 0x15, 0x72,
+	0xd8, 0x0a, 0xb7, 0xb4, 0xc1, 0x85, 0xd1, 0x9f, 0x6a, 0x35, 0x35, 0xc4, 0xd7, 0x50, 0x41, 0x68,
+	0x02, 0xa1, 0xfb, 0x5a, 0x1f, 0xe3, 0x4e, 0xe2, 0x18, 0xdf, 0xb8, 0x52, 0xcd, 0x50, 0xe6, 0x10,
+	0xa5, 0xde, 0x9c, 0x54, 0xb2, 0x33, 0x28, 0xcf, 0xcf, 0x72, 0x2e, 0xad, 0x02, 0xfa, 0xb2, 0x92,
+	0x35, 0x49, 0x0e, 0xf6, 0x89, 0xab, 0xb9, 0x69, 0xcf, 0xf5, 0xfe, 0x28, 0x9b, 0xfd, 0x31, 0x76,
+	0xf4, 0xfa, 0xd1, 0x0c, 0x2a, 0x12, 0x83, 0x68, 0x81, 0x8c, 0x9f, 0x08, 0xd1, 0x3b, 0xf3, 0xd9,
+	0xfb, 0x50, 0x21, 0x43, 0xba, 0x0b, 0xca, 0xb9, 0x84, 0x96, 0xb4, 0x81, 0xb0, 0xf0, 0x94, 0xec,
+	0x53, 0xd8, 0x36, 0x0d, 0xad, 0xe7, 0x33, 0x8e, 0x93, 0x80, 0x6b, 0x22, 0x97, 0xf1, 0x90, 0xb7,
+	0x13, 0x87, 0x7c, 0x46, 0x06, 0xc7, 0x5a, 0x6f, 0xce, 0xb8, 0x45, 0xd8, 0xa4, 0x46, 0xb2, 0x8b,
+	0xc9, 0x68, 0x58, 0xf0, 0x58, 0x79, 0x1b, 0x8f, 0x34, 0x39, 0xe6, 0x1d, 0x3e, 0x05, 0x36, 0x12,
+	0x43, 0x2f, 0xd0, 0x45, 0xf4, 0x3c, 0x31, 0x8c, 0xf4, 0x80, 0xb2, 0x18, 0xb2, 0xe6, 0x5e, 0x92,
+	0x35, 0x64, 0x74, 0x38, 0xb5, 0x31, 0x3e, 0x2b, 0xa3, 0x45, 0x05, 0xfb, 0x0a, 0x2a, 0x7a, 0x70,
+	0xf9, 0x0e, 0x8e, 0x2e, 0xd3, 0xe8, 0x5b, 0x4b, 0xf7, 0xa2, 0x27, 0x98, 0x7f, 0x31, 0xa4, 0x82,
+	0x4d, 0xee, 0x45, 0x25, 0x85, 0x5c, 0xb2, 0x03, 0xc8, 0xd0, 0xc2, 0xb3, 0xaa, 0xe8, 0x60, 0x3b,
+	0xe1, 0x00, 0xc7, 0x68, 0xb2, 0x4b, 0x8d, 0x69, 0xe3, 0x31, 0x94, 0x16, 0xf8, 0xca, 0x8a, 0xb0,
+	0x1a, 0x4e, 0x9e, 0x04, 0xab, 0xa1, 0xcf, 0x1e, 0xc2, 0xaa, 0x1a, 0xe3, 0xd8, 0x9a, 0xf7, 0x99,
+	0x60, 0x1f, 0xf9, 0x5c, 0x55, 0xe3, 0xc6, 0x8f, 0x50, 0x5e, 0xe4, 0x05, 0xbb, 0x0f, 0x80, 0xd1,
+	0x9c, 0xc0, 0x95, 0x01, 0x3a, 0xce, 0xdb, 0x59, 0x94, 0x7c, 0xe9, 0xca, 0x80, 0x7d, 0x06, 0xb9,
+	0x04, 0x65, 0x4c, 0xa0, 0x5b, 0x6f, 0x26, 0xda, 0x64, 0x98, 0x24, 0x00, 0x8d, 0x5f, 0x52, 0x00,
+	0xb3, 0xfc, 0xd8, 0xc1, 0x24, 0x9a, 0xde, 0x2e, 0x18, 0xad, 0xb8, 0x5f, 0x5d, 0x2c, 0x45, 0xfb,
+	0x6a, 0xc0, 0xcd, 0x19, 0xf4, 0x27, 0xdb, 0x85, 0x5c, 0x92, 0x98, 0x34, 0xa3, 0x81, 0xcf, 0xc8,
+	0x78, 0x0f, 0xb2, 0x23, 0xb7, 0x17, 0xfa, 0xae, 0x12, 0x31, 0x3e, 0x6f, 0xb2, 0xf6, 0x4c, 0xb0,
+	0x90, 0x61, 0x7a, 0x21, 0xc3, 0xc6, 0x09, 0x14, 0xe7, 0x59, 0x3a, 0xef, 0x2e, 0xb5, 0xe8, 0xae,
+	0x0a, 0xeb, 0xc9, 0x73, 0xd0, 0x9f, 0x46, 0x1b, 0x0a, 0x73, 0xfc, 0xfc, 0x17, 0x27, 0x6f, 0xf7,
+	0x7a, 0x3b, 0x3a, 0x7b, 0x79, 0x5d, 0x4b, 0xbd, 0xba, 0xae, 0xa5, 0xfe, 0xba, 0xae, 0xa5, 0x7e,
+	0xbe, 0xa9, 0xad, 0xbc, 0xba, 0xa9, 0xad, 0xfc, 0x76, 0x53, 0x5b, 0xf9, 0xbe, 0x95, 0xd8, 0xdd,
+	0x14, 0xb8, 0xcd, 0xdd, 0x7e, 0xab, 0x1f, 0x0c, 0x3b, 0x2d, 0x5c, 0x9d, 0xad, 0x71, 0xcb, 0x3c,
+	0x39, 0x71, 0x91, 0x77, 0x32, 0xf8, 0xd4, 0x3c, 0xf8, 0x7b, 0x00, 0xd7, 0x25, 0x38, 0xad, 0x1d,
+	0x0b, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinterChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MinterChainId))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BatchTimeoutBlocks != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BatchTimeoutBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Stopped {
+		i--
+		if m.Stopped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.SlashFractionConflictingClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionConflictingClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SlashFractionClaim.Size()
+		i -= size
+		if _, err := m.SlashFractionClaim.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.SlashFractionBatch.Size()
+		i -= size
+		if _, err := m.SlashFractionBatch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.SlashFractionValset.Size()
+		i -= size
+		if _, err := m.SlashFractionValset.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.SignedClaimsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedClaimsWindow))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SignedBatchesWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedBatchesWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SignedValsetsWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.SignedValsetsWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MinterAddress) > 0 {
+		i -= len(m.MinterAddress)
+		copy(dAtA[i:], m.MinterAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MinterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StartThreshold != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.TimedOutBatches) > 0 {
+		for iNdEx := len(m.TimedOutBatches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TimedOutBatches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	{
+		size, err := m.VoucherAccounting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.BatchSigningChecks) > 0 {
+		for iNdEx := len(m.BatchSigningChecks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchSigningChecks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ValsetSigningChecks) > 0 {
+		for iNdEx := len(m.ValsetSigningChecks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetSigningChecks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.StartBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartBatchNonce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.StartTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartTxPoolId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.MinterAddresses) > 0 {
+		for iNdEx := len(m.MinterAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LastEventNonces) > 0 {
+		for iNdEx := len(m.LastEventNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastEventNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastObservedEventNonce))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for iNdEx := len(m.BatchConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.UnbatchedTxIds) > 0 {
+		dAtA3 := make([]byte, len(m.UnbatchedTxIds)*10)
+		var j2 int
+		for _, num := range m.UnbatchedTxIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintGenesis(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.LastValset != nil {
+		{
+			size, err := m.LastValset.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for iNdEx := len(m.ValsetConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValsetConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Valsets) > 0 {
+		for iNdEx := len(m.Valsets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valsets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartMinterNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartMinterNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OutgoingTxEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ClaimType != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ClaimType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LastEventNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LastEventNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LastEventNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinterAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinterAddress) > 0 {
+		i -= len(m.MinterAddress)
+		copy(dAtA[i:], m.MinterAddress)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MinterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartThreshold != 0 {
+		n += 1 + sovGenesis(uint64(m.StartThreshold))
+	}
+	l = len(m.MinterAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.BridgeChainId))
+	}
+	if m.SignedValsetsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedValsetsWindow))
+	}
+	if m.SignedBatchesWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedBatchesWindow))
+	}
+	if m.SignedClaimsWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.SignedClaimsWindow))
+	}
+	l = m.SlashFractionValset.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionBatch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionClaim.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.SlashFractionConflictingClaim.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.Stopped {
+		n += 2
+	}
+	if m.BatchTimeoutBlocks != 0 {
+		n += 1 + sovGenesis(uint64(m.BatchTimeoutBlocks))
+	}
+	if m.MinterChainId != 0 {
+		n += 1 + sovGenesis(uint64(m.MinterChainId))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.StartMinterNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.StartMinterNonce))
+	}
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValsetConfirms) > 0 {
+		for _, e := range m.ValsetConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastValset != nil {
+		l = m.LastValset.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UnbatchedTxIds) > 0 {
+		l = 0
+		for _, e := range m.UnbatchedTxIds {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchConfirms) > 0 {
+		for _, e := range m.BatchConfirms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastObservedEventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.LastObservedEventNonce))
+	}
+	if len(m.LastEventNonces) > 0 {
+		for _, e := range m.LastEventNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterAddresses) > 0 {
+		for _, e := range m.MinterAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StartTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.StartTxPoolId))
+	}
+	if m.StartBatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.StartBatchNonce))
+	}
+	if len(m.ValsetSigningChecks) > 0 {
+		for _, e := range m.ValsetSigningChecks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchSigningChecks) > 0 {
+		for _, e := range m.BatchSigningChecks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.VoucherAccounting.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if len(m.TimedOutBatches) > 0 {
+		for _, e := range m.TimedOutBatches {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OutgoingTxEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *AttestationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Attestation.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *ClaimEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimType != 0 {
+		n += 1 + sovGenesis(uint64(m.ClaimType))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.EventNonce))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *LastEventNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MinterAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.MinterAddress)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartThreshold", wireType)
+			}
+			m.StartThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			}
+			m.SignedValsetsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+			}
+			m.SignedBatchesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+			}
+			m.SignedClaimsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Stopped = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeoutBlocks", wireType)
+			}
+			m.BatchTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeoutBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterChainId", wireType)
+			}
+			m.MinterChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinterChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Params == nil {
+				m.Params = &Params{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartMinterNonce", wireType)
+			}
+			m.StartMinterNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartMinterNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valsets = append(m.Valsets, &Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetConfirms = append(m.ValsetConfirms, &MsgValsetConfirm{})
+			if err := m.ValsetConfirms[len(m.ValsetConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastValset == nil {
+				m.LastValset = &Valset{}
+			}
+			if err := m.LastValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, OutgoingTxEntry{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.UnbatchedTxIds = append(m.UnbatchedTxIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.UnbatchedTxIds) == 0 {
+					m.UnbatchedTxIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.UnbatchedTxIds = append(m.UnbatchedTxIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbatchedTxIds", wireType)
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, &OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchConfirms = append(m.BatchConfirms, &MsgConfirmBatch{})
+			if err := m.BatchConfirms[len(m.BatchConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, AttestationEntry{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedEventNonce", wireType)
+			}
+			m.LastObservedEventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastObservedEventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEventNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEventNonces = append(m.LastEventNonces, LastEventNonce{})
+			if err := m.LastEventNonces[len(m.LastEventNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAddresses = append(m.MinterAddresses, MinterAddress{})
+			if err := m.MinterAddresses[len(m.MinterAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTxPoolId", wireType)
+			}
+			m.StartTxPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTxPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBatchNonce", wireType)
+			}
+			m.StartBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetSigningChecks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetSigningChecks = append(m.ValsetSigningChecks, SigningCheck{})
+			if err := m.ValsetSigningChecks[len(m.ValsetSigningChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSigningChecks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BatchSigningChecks = append(m.BatchSigningChecks, SigningCheck{})
+			if err := m.BatchSigningChecks[len(m.BatchSigningChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherAccounting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoucherAccounting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOutBatches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TimedOutBatches = append(m.TimedOutBatches, TimedOutBatch{})
+			if err := m.TimedOutBatches[len(m.TimedOutBatches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, ClaimEntry{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingTxEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -456,17 +2656,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: OutgoingTxEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OutgoingTxEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartThreshold", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.StartThreshold = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -476,16 +2676,102 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartThreshold |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -495,29 +2781,31 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinterAddress = string(dAtA[iNdEx:postIndex])
+			m.ClaimHash = append(m.ClaimHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClaimHash == nil {
+				m.ClaimHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			m.BridgeChainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -527,35 +2815,83 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedValsetsWindow", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			m.SignedValsetsWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SignedValsetsWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-		case 5:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedBatchesWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimType", wireType)
 			}
-			m.SignedBatchesWindow = 0
+			m.ClaimType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -565,16 +2901,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedBatchesWindow |= uint64(b&0x7F) << shift
+				m.ClaimType |= ClaimType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SignedClaimsWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.SignedClaimsWindow = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -584,16 +2920,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SignedClaimsWindow |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionValset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -603,28 +2939,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionValset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionBatch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -651,48 +2986,69 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionBatch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ClaimHash = append(m.ClaimHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClaimHash == nil {
+				m.ClaimHash = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionClaim", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenesis
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if skippy < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastEventNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
 			}
-			iNdEx = postIndex
-		case 10:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastEventNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastEventNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionConflictingClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -702,30 +3058,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SlashFractionConflictingClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stopped", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var v int
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -735,12 +3090,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Stopped = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -765,7 +3119,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *MinterAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -788,17 +3142,17 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: MinterAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MinterAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -808,33 +3162,29 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenesis
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Params == nil {
-				m.Params = &Params{}
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartMinterNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterAddress", wireType)
 			}
-			m.StartMinterNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -844,11 +3194,24 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartMinterNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -35,6 +38,9 @@ var (
 	// NOTE: this should be refactored to take a cosmos account address
 	OracleClaimKey = []byte{0x4}
 
+	// OracleAttestationKey indexes attestations by event nonce and the claim details hash
+	OracleAttestationKey = []byte{0x5}
+
 	// OutgoingTXPoolKey indexes the last nonce for the outgoing tx pool
 	OutgoingTXPoolKey = []byte{0x6}
 
@@ -62,6 +68,27 @@ var (
 	// LastValsetKey
 	LastValsetKey = []byte{0xf3}
 
+	// ValsetSigningCheckKey indexes the pending signing checks of valsets by nonce
+	ValsetSigningCheckKey = []byte{0xf4}
+
+	// BatchSigningCheckKey indexes the pending signing checks of batches by nonce
+	BatchSigningCheckKey = []byte{0xf5}
+
+	// VoucherAccountingKey indexes the vouchers minted, burned and released by the module
+	VoucherAccountingKey = []byte{0xf6}
+
+	// OutgoingTXSenderIndexKey indexes the outgoing pool by sender
+	OutgoingTXSenderIndexKey = []byte{0xf7}
+
+	// OutgoingTXReceiverIndexKey indexes the outgoing pool by destination address
+	OutgoingTXReceiverIndexKey = []byte{0xf8}
+
+	// OutgoingTXHashIndexKey indexes the outgoing pool by the hash of the inbound tx
+	OutgoingTXHashIndexKey = []byte{0xf9}
+
+	// TimedOutBatchKey indexes the tombstones of the timed out batches by batch nonce
+	TimedOutBatchKey = []byte{0xfa}
+
 	// SequenceKeyPrefix indexes different txids
 	SequenceKeyPrefix = []byte{0x7}
 
@@ -107,6 +134,11 @@ func GetClaimKey(claimType ClaimType, nonce uint64, validator sdk.ValAddress, de
 	} else {
 		panic("No claim without details!")
 	}
+	return GetClaimKeyWithHash(claimType, nonce, validator, detailsHash)
+}
+
+// GetClaimKeyWithHash returns the claim key of the claim details hash, see GetClaimKey
+func GetClaimKeyWithHash(claimType ClaimType, nonce uint64, validator sdk.ValAddress, detailsHash []byte) []byte {
 	claimTypeLen := len([]byte{byte(claimType)})
 	nonceBz := UInt64Bytes(nonce)
 	key := make([]byte, len(OracleClaimKey)+claimTypeLen+sdk.AddrLen+len(nonceBz)+len(detailsHash))
@@ -120,9 +152,15 @@ func GetClaimKey(claimType ClaimType, nonce uint64, validator sdk.ValAddress, de
 
 // GetAttestationKey returns the following key format
 // prefix     nonce                             attestation-details-hash
-// [0x6][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
+// [0x5][0 0 0 0 0 0 0 1][fd1af8cec6c67fcf156f1b61fdf91ebc04d05484d007436e75342fc05bbff35a]
 func GetAttestationKey(eventNonce uint64, details MinterClaim) []byte {
-	return append(UInt64Bytes(eventNonce), details.ClaimHash()...)
+	return GetAttestationKeyWithHash(eventNonce, details.ClaimHash())
+}
+
+// GetAttestationKeyWithHash returns the attestation key of the event nonce and the claim details hash, see
+// GetAttestationKey
+func GetAttestationKeyWithHash(eventNonce uint64, claimHash []byte) []byte {
+	return append(OracleAttestationKey, append(UInt64Bytes(eventNonce), claimHash...)...)
 }
 
 // GetOutgoingTxPoolKey returns the following key format
@@ -132,6 +170,27 @@ func GetOutgoingTxPoolKey(id uint64) []byte {
 	return append(OutgoingTXPoolKey, sdk.Uint64ToBigEndian(id)...)
 }
 
+// GetOutgoingTxIndexPrefix returns the prefix of the outgoing pool index entries with the given value, the value is
+// prefixed with its uvarint encoded length so that the values which are prefixes of each other do not overlap
+// prefix  length  value
+// [0xf7][42][hub1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetOutgoingTxIndexPrefix(indexKey []byte, value string) []byte {
+	value = strings.ToLower(value)
+
+	key := make([]byte, len(indexKey)+binary.MaxVarintLen64, len(indexKey)+binary.MaxVarintLen64+len(value))
+	copy(key, indexKey)
+	n := binary.PutUvarint(key[len(indexKey):], uint64(len(value)))
+	key = key[:len(indexKey)+n]
+	return append(key, value...)
+}
+
+// GetOutgoingTxIndexKey returns the following key format
+// prefix  length  value                                         id
+// [0xf7][42][hub1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetOutgoingTxIndexKey(indexKey []byte, value string, id uint64) []byte {
+	return append(GetOutgoingTxIndexPrefix(indexKey, value), sdk.Uint64ToBigEndian(id)...)
+}
+
 // GetOutgoingTxBatchKey returns the following key format
 // prefix     nonce                     eth-contract-address
 // [0xa][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
@@ -139,6 +198,13 @@ func GetOutgoingTxBatchKey(nonce uint64) []byte {
 	return append(OutgoingTXBatchKey, UInt64Bytes(nonce)...)
 }
 
+// GetTimedOutBatchKey returns the following key format
+// prefix    nonce
+// [0xfa][0 0 0 0 0 0 0 1]
+func GetTimedOutBatchKey(nonce uint64) []byte {
+	return append(TimedOutBatchKey, UInt64Bytes(nonce)...)
+}
+
 // GetBatchConfirmKey returns the following key format
 // prefix           eth-contract-address                             cosmos-address
 // [0xe1][0xc783df8a850f42e7F7e57013759C285caa701eB6][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
@@ -156,3 +222,17 @@ func GetBatchConfirmKey(batchNonce uint64, validator sdk.AccAddress) []byte {
 func GetLastEventNonceByValidatorKey(validator sdk.ValAddress) []byte {
 	return append(LastEventNonceByValidatorKey, validator.Bytes()...)
 }
+
+// GetValsetSigningCheckKey returns the following key format
+// prefix    nonce
+// [0xf4][0 0 0 0 0 0 0 1]
+func GetValsetSigningCheckKey(nonce uint64) []byte {
+	return append(ValsetSigningCheckKey, UInt64Bytes(nonce)...)
+}
+
+// GetBatchSigningCheckKey returns the following key format
+// prefix    nonce
+// [0xf5][0 0 0 0 0 0 0 1]
+func GetBatchSigningCheckKey(nonce uint64) []byte {
+	return append(BatchSigningCheckKey, UInt64Bytes(nonce)...)
+}
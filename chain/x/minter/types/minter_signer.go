@@ -2,6 +2,7 @@ package types
 
 import (
 	"crypto/ecdsa"
+	"strings"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -31,7 +32,7 @@ func ValidateMinterSignature(hash []byte, signature []byte, minterAddress string
 	}
 
 	addr := crypto.PubkeyToAddress(*pubkey)
-	if "Mx"+addr.Hex()[2:] != minterAddress { // todo
+	if !strings.EqualFold("Mx"+addr.Hex()[2:], minterAddress) {
 		return sdkerrors.Wrap(ErrInvalid, "signature not matching")
 	}
 
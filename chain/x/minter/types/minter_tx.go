@@ -0,0 +1,170 @@
+package types
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+const (
+	// MinterMultisigThreshold is the weight threshold of the Minter multisig
+	MinterMultisigThreshold = 667
+
+	// MinterMultisigTotalWeight is the sum of the weights distributed among the valset members
+	MinterMultisigTotalWeight = 1000
+
+	minterTxTypeMultisend    = 0x0D
+	minterTxTypeEditMultisig = 0x12
+
+	minterSignatureTypeMulti = 2
+
+	minterGasPrice = 1
+	minterGasCoin  = 0
+)
+
+type minterSendData struct {
+	Coin  uint32
+	To    [20]byte
+	Value *big.Int
+}
+
+type minterMultisendData struct {
+	List []minterSendData
+}
+
+type minterEditMultisigData struct {
+	Threshold uint32
+	Weights   []uint32
+	Addresses [][20]byte
+}
+
+type minterSignature struct {
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// GetMinterSigningData returns the RLP encoded multisend transaction which validators sign to execute the batch on
+// Minter. The batch nonce is carried in the payload. The multisig address is a part of the signature data and is not
+// covered by the signature itself.
+func (b OutgoingTxBatch) GetMinterSigningData(chainID uint64) ([]byte, error) {
+	data := minterMultisendData{}
+	for _, tx := range b.Transactions {
+		to, err := minterAddressToBytes(tx.DestAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "tx %d", tx.Id)
+		}
+
+		data.List = append(data.List, minterSendData{
+			Coin:  uint32(tx.MinterToken.CoinId),
+			To:    to,
+			Value: tx.MinterToken.Amount.BigInt(),
+		})
+	}
+
+	return minterTxSigningData(b.MinterNonce, chainID, minterTxTypeMultisend, data, []byte(strconv.Itoa(int(b.BatchNonce))))
+}
+
+// GetMinterSigningData returns the RLP encoded edit multisig transaction which validators sign to apply the valset
+// on Minter
+func (v Valset) GetMinterSigningData(chainID uint64) ([]byte, error) {
+	data := minterEditMultisigData{
+		Threshold: MinterMultisigThreshold,
+		Weights:   v.MinterWeights(),
+	}
+
+	for _, member := range v.Members {
+		addr, err := minterAddressToBytes(member.MinterAddress)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "member")
+		}
+
+		data.Addresses = append(data.Addresses, addr)
+	}
+
+	return minterTxSigningData(v.MinterNonce, chainID, minterTxTypeEditMultisig, data, []byte(strconv.Itoa(int(v.Nonce))))
+}
+
+// MinterWeights returns the weights of the valset members in the Minter multisig
+func (v Valset) MinterWeights() []uint32 {
+	totalPower := BridgeValidators(v.Members).TotalPower()
+
+	weights := make([]uint32, len(v.Members))
+	if totalPower == 0 {
+		return weights
+	}
+
+	for i, member := range v.Members {
+		weights[i] = uint32(sdk.NewUint(member.Power).MulUint64(MinterMultisigTotalWeight).QuoUint64(totalPower).Uint64())
+	}
+
+	return weights
+}
+
+// DecodeMinterSignature converts hex encoded single signature data of a Minter transaction into 65 bytes
+// [R || S || V] format
+func DecodeMinterSignature(signature string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(signature, "0x"))
+	if err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalid, "signature decoding")
+	}
+
+	sig := minterSignature{}
+	if err := rlp.DecodeBytes(bz, &sig); err != nil {
+		return nil, sdkerrors.Wrap(ErrInvalid, "signature rlp decoding")
+	}
+
+	if sig.R.BitLen() > 256 || sig.S.BitLen() > 256 || sig.V.Cmp(big.NewInt(27)) < 0 || sig.V.Cmp(big.NewInt(28)) > 0 {
+		return nil, sdkerrors.Wrap(ErrInvalid, "signature values")
+	}
+
+	out := make([]byte, 65)
+	copy(out[32-len(sig.R.Bytes()):32], sig.R.Bytes())
+	copy(out[64-len(sig.S.Bytes()):64], sig.S.Bytes())
+	out[64] = byte(sig.V.Uint64() - 27)
+
+	return out, nil
+}
+
+func minterTxSigningData(nonce uint64, chainID uint64, txType uint64, data interface{}, payload []byte) ([]byte, error) {
+	if chainID == 0 {
+		return nil, sdkerrors.Wrap(ErrEmpty, "minter chain id")
+	}
+
+	encodedData, err := rlp.EncodeToBytes(data)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "tx data encoding")
+	}
+
+	return rlp.EncodeToBytes([]interface{}{
+		nonce,
+		chainID,
+		uint64(minterGasPrice),
+		uint64(minterGasCoin),
+		txType,
+		encodedData,
+		payload,
+		[]byte{}, // service data
+		uint64(minterSignatureTypeMulti),
+	})
+}
+
+func minterAddressToBytes(address string) ([20]byte, error) {
+	var out [20]byte
+	if err := ValidateMinterAddress(address); err != nil {
+		return out, sdkerrors.Wrap(ErrInvalid, err.Error())
+	}
+
+	bz, err := hex.DecodeString(address[2:])
+	if err != nil {
+		return out, sdkerrors.Wrap(ErrInvalid, "address decoding")
+	}
+
+	copy(out[:], bz)
+	return out, nil
+}
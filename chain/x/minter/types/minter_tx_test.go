@@ -0,0 +1,193 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchMinterSignature(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	minterAddress := "Mx" + crypto.PubkeyToAddress(privateKey.PublicKey).Hex()[2:]
+
+	batch := OutgoingTxBatch{
+		BatchNonce:  1,
+		MinterNonce: 5,
+		Transactions: []*OutgoingTransferTx{
+			{
+				Id:          1,
+				DestAddress: "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56",
+				MinterToken: NewMinterCoin(sdk.NewInt(1000), 1),
+			},
+		},
+	}
+
+	signingData, err := batch.GetMinterSigningData(1)
+	require.NoError(t, err)
+	signature := signMinterTx(t, signingData, privateKey)
+
+	specs := map[string]struct {
+		batchNonce       uint64
+		batchMinterNonce uint64
+		chainID          uint64
+		expErr           bool
+	}{
+		"all good": {
+			batchNonce:       1,
+			batchMinterNonce: 5,
+			chainID:          1,
+		},
+		"other batch nonce": {
+			batchNonce:       2,
+			batchMinterNonce: 5,
+			chainID:          1,
+			expErr:           true,
+		},
+		"other minter nonce": {
+			batchNonce:       1,
+			batchMinterNonce: 6,
+			chainID:          1,
+			expErr:           true,
+		},
+		"other chain": {
+			batchNonce:       1,
+			batchMinterNonce: 5,
+			chainID:          2,
+			expErr:           true,
+		},
+		"empty chain": {
+			batchNonce:       1,
+			batchMinterNonce: 5,
+			expErr:           true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			b := batch
+			b.BatchNonce = spec.batchNonce
+			b.MinterNonce = spec.batchMinterNonce
+
+			err := validateSigningData(b.GetMinterSigningData(spec.chainID))(signature, minterAddress)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestValsetMinterSignature(t *testing.T) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	minterAddress := "Mx" + crypto.PubkeyToAddress(privateKey.PublicKey).Hex()[2:]
+
+	valset := Valset{
+		Nonce:       10,
+		MinterNonce: 3,
+		Members: []*BridgeValidator{
+			{Power: 3000, MinterAddress: minterAddress},
+			{Power: 1000, MinterAddress: "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56"},
+		},
+	}
+	assert.Equal(t, []uint32{750, 250}, valset.MinterWeights())
+
+	signingData, err := valset.GetMinterSigningData(2)
+	require.NoError(t, err)
+	signature := signMinterTx(t, signingData, privateKey)
+
+	assert.NoError(t, validateSigningData(valset.GetMinterSigningData(2))(signature, minterAddress))
+	assert.Error(t, validateSigningData(valset.GetMinterSigningData(2))(signature, valset.Members[1].MinterAddress))
+
+	valset.Nonce = 11
+	assert.Error(t, validateSigningData(valset.GetMinterSigningData(2))(signature, minterAddress))
+}
+
+// TestMinterSignatureGolden checks the signing data against the signatures made by minter-go-sdk with the multisig
+// signature type, the same way the minter-connector signs the batches and valsets
+func TestMinterSignatureGolden(t *testing.T) {
+	const (
+		chainID       = 2
+		minterAddress = "Mx31e61a05adbd13c6b625262704bc305bf7725026"
+	)
+
+	batch := OutgoingTxBatch{
+		BatchNonce:  7,
+		MinterNonce: 42,
+		Transactions: []*OutgoingTransferTx{
+			{
+				Id:          1,
+				DestAddress: "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56",
+				MinterToken: NewMinterCoin(sdk.NewInt(1000), 1),
+			},
+			{
+				Id:          2,
+				DestAddress: "Mx7f0fc21d932f38ca9444f61703174569066cfa50",
+				MinterToken: NewMinterCoin(sdk.NewInt(25).Mul(sdk.NewInt(1e18)), 1833),
+			},
+		},
+	}
+	const batchSignature = "f8431ba0ded92733bd083039ab79531ababf3cdc88f703c6d2941ad76739d41a70da1df0a066cffb04f4ca1662f161b9b0cbb21c3b18c96d6aa49e1026ed3e6a6699240bd5"
+	assert.NoError(t, validateSigningData(batch.GetMinterSigningData(chainID))(batchSignature, minterAddress))
+
+	valset := Valset{
+		Nonce:       3,
+		MinterNonce: 43,
+		Members: []*BridgeValidator{
+			{Power: 3, MinterAddress: "Mx68f4839d7f32831b9234f9575f3b95e1afe21a56"},
+			{Power: 1, MinterAddress: "Mx7f0fc21d932f38ca9444f61703174569066cfa50"},
+		},
+	}
+	const valsetSignature = "f8431ca04f74d128be01d424f8c05db60f99f22a922053918261b9c515e91c563d4bdc5da0036e5d3310d9572a29f9e85814382df3fe1bb24f3b3ce288bf62f3c4ca07dd51"
+	assert.NoError(t, validateSigningData(valset.GetMinterSigningData(chainID))(valsetSignature, minterAddress))
+}
+
+func TestDecodeMinterSignature(t *testing.T) {
+	_, err := DecodeMinterSignature("zz")
+	assert.Error(t, err)
+
+	_, err = DecodeMinterSignature("c0")
+	assert.Error(t, err)
+
+	bz, err := rlp.EncodeToBytes(minterSignature{V: big.NewInt(29), R: big.NewInt(1), S: big.NewInt(1)})
+	require.NoError(t, err)
+	_, err = DecodeMinterSignature(hex.EncodeToString(bz))
+	assert.Error(t, err)
+}
+
+func validateSigningData(signingData []byte, err error) func(string, string) error {
+	return func(signature string, minterAddress string) error {
+		if err != nil {
+			return err
+		}
+
+		sigBytes, err := DecodeMinterSignature(signature)
+		if err != nil {
+			return err
+		}
+
+		return ValidateMinterSignature(signingData, sigBytes, minterAddress)
+	}
+}
+
+func signMinterTx(t *testing.T, signingData []byte, privateKey *ecdsa.PrivateKey) string {
+	sig, err := NewMinterSignature(signingData, privateKey)
+	require.NoError(t, err)
+
+	bz, err := rlp.EncodeToBytes(minterSignature{
+		V: big.NewInt(int64(sig[64]) + 27),
+		R: new(big.Int).SetBytes(sig[:32]),
+		S: new(big.Int).SetBytes(sig[32:64]),
+	})
+	require.NoError(t, err)
+
+	return hex.EncodeToString(bz)
+}
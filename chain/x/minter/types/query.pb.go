@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -1157,6 +1158,383 @@ func (m *QueryLastEventNonceByAddrResponse) GetEventNonce() uint64 {
 	return 0
 }
 
+// PendingTransfer is a transfer of the outgoing pool. The batch nonce is zero while the transfer is unbatched.
+type PendingTransfer struct {
+	Id         uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tx         OutgoingTx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
+	BatchNonce uint64     `protobuf:"varint,3,opt,name=batch_nonce,json=batchNonce,proto3" json:"batch_nonce,omitempty"`
+}
+
+func (m *PendingTransfer) Reset()         { *m = PendingTransfer{} }
+func (m *PendingTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingTransfer) ProtoMessage()    {}
+func (*PendingTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{26}
+}
+func (m *PendingTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingTransfer.Merge(m, src)
+}
+func (m *PendingTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingTransfer proto.InternalMessageInfo
+
+func (m *PendingTransfer) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PendingTransfer) GetTx() OutgoingTx {
+	if m != nil {
+		return m.Tx
+	}
+	return OutgoingTx{}
+}
+
+func (m *PendingTransfer) GetBatchNonce() uint64 {
+	if m != nil {
+		return m.BatchNonce
+	}
+	return 0
+}
+
+type QueryPendingTransfersBySenderRequest struct {
+	Sender     string             `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransfersBySenderRequest) Reset()         { *m = QueryPendingTransfersBySenderRequest{} }
+func (m *QueryPendingTransfersBySenderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransfersBySenderRequest) ProtoMessage()    {}
+func (*QueryPendingTransfersBySenderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{27}
+}
+func (m *QueryPendingTransfersBySenderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransfersBySenderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransfersBySenderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransfersBySenderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransfersBySenderRequest.Merge(m, src)
+}
+func (m *QueryPendingTransfersBySenderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransfersBySenderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransfersBySenderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransfersBySenderRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTransfersBySenderRequest) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *QueryPendingTransfersBySenderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingTransfersBySenderResponse struct {
+	Transfers  []PendingTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransfersBySenderResponse) Reset()         { *m = QueryPendingTransfersBySenderResponse{} }
+func (m *QueryPendingTransfersBySenderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransfersBySenderResponse) ProtoMessage()    {}
+func (*QueryPendingTransfersBySenderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{28}
+}
+func (m *QueryPendingTransfersBySenderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransfersBySenderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransfersBySenderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransfersBySenderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransfersBySenderResponse.Merge(m, src)
+}
+func (m *QueryPendingTransfersBySenderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransfersBySenderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransfersBySenderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransfersBySenderResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTransfersBySenderResponse) GetTransfers() []PendingTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *QueryPendingTransfersBySenderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingTransfersByReceiverRequest struct {
+	Receiver   string             `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransfersByReceiverRequest) Reset() {
+	*m = QueryPendingTransfersByReceiverRequest{}
+}
+func (m *QueryPendingTransfersByReceiverRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransfersByReceiverRequest) ProtoMessage()    {}
+func (*QueryPendingTransfersByReceiverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{29}
+}
+func (m *QueryPendingTransfersByReceiverRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransfersByReceiverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransfersByReceiverRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransfersByReceiverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransfersByReceiverRequest.Merge(m, src)
+}
+func (m *QueryPendingTransfersByReceiverRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransfersByReceiverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransfersByReceiverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransfersByReceiverRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTransfersByReceiverRequest) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *QueryPendingTransfersByReceiverRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPendingTransfersByReceiverResponse struct {
+	Transfers  []PendingTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTransfersByReceiverResponse) Reset() {
+	*m = QueryPendingTransfersByReceiverResponse{}
+}
+func (m *QueryPendingTransfersByReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTransfersByReceiverResponse) ProtoMessage()    {}
+func (*QueryPendingTransfersByReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{30}
+}
+func (m *QueryPendingTransfersByReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTransfersByReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTransfersByReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTransfersByReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTransfersByReceiverResponse.Merge(m, src)
+}
+func (m *QueryPendingTransfersByReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTransfersByReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTransfersByReceiverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTransfersByReceiverResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTransfersByReceiverResponse) GetTransfers() []PendingTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *QueryPendingTransfersByReceiverResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTransferByTxHashRequest struct {
+	TxHash     string             `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferByTxHashRequest) Reset()         { *m = QueryTransferByTxHashRequest{} }
+func (m *QueryTransferByTxHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferByTxHashRequest) ProtoMessage()    {}
+func (*QueryTransferByTxHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{31}
+}
+func (m *QueryTransferByTxHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferByTxHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferByTxHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferByTxHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferByTxHashRequest.Merge(m, src)
+}
+func (m *QueryTransferByTxHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferByTxHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferByTxHashRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferByTxHashRequest proto.InternalMessageInfo
+
+func (m *QueryTransferByTxHashRequest) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *QueryTransferByTxHashRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryTransferByTxHashResponse struct {
+	Transfers  []PendingTransfer   `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTransferByTxHashResponse) Reset()         { *m = QueryTransferByTxHashResponse{} }
+func (m *QueryTransferByTxHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTransferByTxHashResponse) ProtoMessage()    {}
+func (*QueryTransferByTxHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2c9ae69584357674, []int{32}
+}
+func (m *QueryTransferByTxHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTransferByTxHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTransferByTxHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTransferByTxHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTransferByTxHashResponse.Merge(m, src)
+}
+func (m *QueryTransferByTxHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTransferByTxHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTransferByTxHashResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTransferByTxHashResponse proto.InternalMessageInfo
+
+func (m *QueryTransferByTxHashResponse) GetTransfers() []PendingTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *QueryTransferByTxHashResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "minter.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "minter.v1.QueryParamsResponse")
@@ -1184,76 +1562,104 @@ func init() {
 	proto.RegisterType((*QueryBatchConfirmsResponse)(nil), "minter.v1.QueryBatchConfirmsResponse")
 	proto.RegisterType((*QueryLastEventNonceByAddrRequest)(nil), "minter.v1.QueryLastEventNonceByAddrRequest")
 	proto.RegisterType((*QueryLastEventNonceByAddrResponse)(nil), "minter.v1.QueryLastEventNonceByAddrResponse")
+	proto.RegisterType((*PendingTransfer)(nil), "minter.v1.PendingTransfer")
+	proto.RegisterType((*QueryPendingTransfersBySenderRequest)(nil), "minter.v1.QueryPendingTransfersBySenderRequest")
+	proto.RegisterType((*QueryPendingTransfersBySenderResponse)(nil), "minter.v1.QueryPendingTransfersBySenderResponse")
+	proto.RegisterType((*QueryPendingTransfersByReceiverRequest)(nil), "minter.v1.QueryPendingTransfersByReceiverRequest")
+	proto.RegisterType((*QueryPendingTransfersByReceiverResponse)(nil), "minter.v1.QueryPendingTransfersByReceiverResponse")
+	proto.RegisterType((*QueryTransferByTxHashRequest)(nil), "minter.v1.QueryTransferByTxHashRequest")
+	proto.RegisterType((*QueryTransferByTxHashResponse)(nil), "minter.v1.QueryTransferByTxHashResponse")
 }
 
 func init() { proto.RegisterFile("minter/v1/query.proto", fileDescriptor_2c9ae69584357674) }
 
 var fileDescriptor_2c9ae69584357674 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x5f, 0x6f, 0xdb, 0x54,
-	0x14, 0xaf, 0xc7, 0xda, 0xb2, 0x33, 0x0d, 0x69, 0x77, 0x2d, 0x2d, 0x77, 0x8d, 0x93, 0x5a, 0x1b,
-	0xf4, 0x1f, 0xb9, 0xb4, 0xac, 0x9b, 0x90, 0x78, 0x21, 0xfb, 0x83, 0x10, 0x6c, 0x8c, 0x52, 0x4d,
-	0x08, 0x09, 0x90, 0x93, 0xdc, 0xb9, 0x96, 0x12, 0x3b, 0xb3, 0x9d, 0xd0, 0xa8, 0xda, 0x0b, 0x7c,
-	0x01, 0xd0, 0x78, 0xe5, 0x91, 0x4f, 0x00, 0x1f, 0x62, 0x8f, 0x95, 0x78, 0xe1, 0x09, 0xa1, 0x96,
-	0x57, 0xbe, 0x03, 0xf2, 0xbd, 0xc7, 0x4e, 0x6c, 0x5f, 0xff, 0xc9, 0x9e, 0x92, 0x1c, 0xff, 0xce,
-	0xf9, 0xfd, 0xee, 0xf5, 0x39, 0xe7, 0xa7, 0xc0, 0x72, 0xdf, 0x76, 0x02, 0xee, 0xb1, 0xd1, 0x2e,
-	0x7b, 0x36, 0xe4, 0xde, 0xb8, 0x39, 0xf0, 0xdc, 0xc0, 0x25, 0x97, 0x64, 0xb8, 0x39, 0xda, 0xa5,
-	0x2b, 0x13, 0x84, 0xc5, 0x1d, 0xee, 0xdb, 0xbe, 0xc4, 0xd0, 0xa9, 0xd4, 0x60, 0x3c, 0xe0, 0x51,
-	0x78, 0x69, 0x12, 0xee, 0xfb, 0x96, 0x02, 0xdc, 0x36, 0x83, 0xce, 0x11, 0x86, 0xd7, 0x2c, 0xd7,
-	0xb5, 0x7a, 0x9c, 0x99, 0x03, 0x9b, 0x99, 0x8e, 0xe3, 0x06, 0x66, 0x60, 0xbb, 0x4e, 0x5c, 0xca,
-	0x72, 0x2d, 0x57, 0x7c, 0x65, 0xe1, 0x37, 0x19, 0x35, 0x96, 0x80, 0x7c, 0x11, 0x4a, 0x7d, 0x6c,
-	0x7a, 0x66, 0xdf, 0x3f, 0xe0, 0xcf, 0x86, 0xdc, 0x0f, 0x8c, 0x07, 0x70, 0x2d, 0x11, 0xf5, 0x07,
-	0xae, 0xe3, 0x73, 0xc2, 0x60, 0x61, 0x20, 0x22, 0xab, 0x5a, 0x43, 0xdb, 0xb8, 0xbc, 0x77, 0xb5,
-	0x19, 0x9f, 0xac, 0x29, 0xa1, 0xad, 0x8b, 0x2f, 0xff, 0xae, 0xcf, 0x1d, 0x20, 0xcc, 0xb8, 0x0e,
-	0x6f, 0x89, 0x3a, 0x77, 0x87, 0x9e, 0xc7, 0x9d, 0xe0, 0x89, 0xd9, 0xf3, 0x79, 0x10, 0x91, 0x7c,
-	0x0c, 0x54, 0xf5, 0x10, 0xb9, 0x36, 0x61, 0x61, 0x24, 0x22, 0x0a, 0x2e, 0x84, 0x22, 0xc0, 0xd8,
-	0x45, 0x96, 0x44, 0x79, 0xfc, 0x20, 0x4b, 0x30, 0xef, 0xb8, 0x4e, 0x87, 0x8b, 0x32, 0x17, 0x0f,
-	0xe4, 0x8f, 0x98, 0x3b, 0x95, 0x32, 0x3b, 0xf7, 0xa7, 0x09, 0xee, 0xbb, 0xae, 0xf3, 0xd4, 0xf6,
-	0xfa, 0x85, 0xdc, 0x64, 0x15, 0x16, 0xcd, 0x6e, 0xd7, 0xe3, 0xbe, 0xbf, 0x7a, 0xa1, 0xa1, 0x6d,
-	0x5c, 0x3a, 0x88, 0x7e, 0x1a, 0x5f, 0x26, 0x54, 0xc5, 0xc5, 0x50, 0xd5, 0x3e, 0x2c, 0x76, 0x64,
-	0x08, 0x65, 0x5d, 0x9f, 0x92, 0xf5, 0xd0, 0xb7, 0x92, 0x59, 0x11, 0xd6, 0xf8, 0x00, 0xd6, 0xb3,
-	0x45, 0xfd, 0xd6, 0xf8, 0x51, 0x28, 0xa6, 0xf8, 0x96, 0xbe, 0x01, 0xa3, 0x28, 0x15, 0x75, 0xdd,
-	0x81, 0xd7, 0x91, 0x2b, 0xec, 0x8b, 0xd7, 0xca, 0x84, 0xc5, 0x60, 0xa3, 0x01, 0xba, 0x28, 0xff,
-	0x99, 0xe9, 0x27, 0x5b, 0x23, 0xee, 0xc3, 0x47, 0x50, 0xcf, 0x45, 0x20, 0xfb, 0x36, 0x2c, 0xca,
-	0x57, 0x11, 0x91, 0x2b, 0x5e, 0x56, 0x84, 0x30, 0x56, 0xe1, 0x4d, 0x75, 0x3d, 0xe3, 0x1e, 0xac,
-	0x64, 0x9e, 0xcc, 0xde, 0x0d, 0x0f, 0x60, 0x2b, 0xae, 0xf2, 0x98, 0x3b, 0x5d, 0xdb, 0xb1, 0x12,
-	0x34, 0xad, 0xf1, 0x47, 0xdd, 0xae, 0x17, 0x5d, 0xfa, 0x54, 0x23, 0x68, 0xc9, 0x46, 0xf8, 0x0a,
-	0xb6, 0x2b, 0xd5, 0x99, 0x5d, 0xe1, 0x7d, 0xd8, 0x4c, 0x57, 0x6e, 0x85, 0x2b, 0x64, 0x46, 0x81,
-	0xdf, 0x66, 0x0f, 0xaa, 0x2a, 0x83, 0xfa, 0xde, 0x83, 0x79, 0xb1, 0xa7, 0x50, 0x1e, 0x9d, 0x92,
-	0xf7, 0xf9, 0x30, 0xb0, 0x5c, 0xdb, 0xb1, 0x0e, 0x8f, 0x65, 0xbe, 0x04, 0x1a, 0x75, 0xa8, 0x89,
-	0xfa, 0xa9, 0xc7, 0x3c, 0xee, 0x8c, 0x27, 0xd8, 0x3b, 0x0a, 0x00, 0x92, 0xde, 0x82, 0xc5, 0xb6,
-	0x0c, 0x61, 0x63, 0x14, 0xd1, 0x46, 0x50, 0xe3, 0x0e, 0x76, 0x5c, 0xf2, 0x34, 0x15, 0x66, 0xe5,
-	0x10, 0x1a, 0xf9, 0x89, 0xaf, 0x7c, 0x0f, 0xd1, 0x6a, 0x13, 0xc1, 0x68, 0x00, 0xcb, 0x84, 0x50,
-	0x55, 0x0a, 0x4a, 0xb8, 0x9d, 0x19, 0x56, 0x9a, 0x1c, 0x56, 0xcc, 0x90, 0x2a, 0x26, 0xb3, 0xfa,
-	0x21, 0x1e, 0x2f, 0x7c, 0xe1, 0xf7, 0x47, 0xdc, 0x09, 0xc4, 0xc9, 0xaa, 0xb6, 0xcb, 0x3d, 0xdc,
-	0x41, 0xea, 0x6c, 0x94, 0x56, 0x87, 0xcb, 0x3c, 0x7c, 0xf6, 0xdd, 0xf4, 0xa1, 0x80, 0xc7, 0xf0,
-	0xbd, 0xff, 0xde, 0x80, 0x79, 0x51, 0x86, 0x3c, 0x85, 0x05, 0xe9, 0x37, 0xa4, 0x36, 0xa5, 0x3e,
-	0x6b, 0x64, 0x54, 0xcf, 0x7b, 0x2c, 0x39, 0x8d, 0xda, 0x0f, 0x7f, 0xfe, 0xfb, 0xe2, 0xc2, 0x0a,
-	0x59, 0x66, 0xb1, 0xa5, 0xb6, 0x79, 0x60, 0x32, 0xe9, 0x5f, 0xe4, 0x47, 0x0d, 0xae, 0x24, 0xec,
-	0x89, 0xdc, 0x48, 0x17, 0x54, 0x59, 0x1b, 0xbd, 0x59, 0x82, 0x42, 0xf6, 0x9b, 0x82, 0xbd, 0x4e,
-	0x6a, 0x29, 0x76, 0x39, 0xab, 0xac, 0x23, 0x93, 0xc8, 0x09, 0x5c, 0x49, 0x94, 0xcf, 0x8a, 0x50,
-	0x39, 0x5f, 0x56, 0x84, 0xd2, 0xec, 0x72, 0xaf, 0x40, 0x8a, 0x10, 0x57, 0x90, 0x58, 0xe0, 0x79,
-	0xec, 0x49, 0xef, 0xcb, 0x63, 0x4f, 0x99, 0x5a, 0xe9, 0x15, 0x20, 0xe7, 0xaf, 0x1a, 0x2c, 0x2b,
-	0x5d, 0x88, 0xec, 0x14, 0xf2, 0xa4, 0x7c, 0x8e, 0xbe, 0x5b, 0x11, 0x8d, 0xea, 0xde, 0x11, 0xea,
-	0xd6, 0x49, 0x3d, 0xa5, 0x2e, 0x1a, 0x0b, 0x76, 0x22, 0x7a, 0xf5, 0x39, 0x79, 0xa1, 0x01, 0xc9,
-	0x9a, 0x14, 0xd9, 0x4c, 0xd3, 0xe5, 0x5a, 0x1d, 0xdd, 0xaa, 0x02, 0x45, 0x59, 0x6f, 0x0b, 0x59,
-	0x0d, 0xa2, 0xab, 0x2f, 0xcd, 0x8b, 0xe8, 0x7f, 0xd7, 0x40, 0x2f, 0xb6, 0x10, 0xb2, 0xaf, 0xa2,
-	0x2d, 0xb5, 0x2e, 0x7a, 0x7b, 0xd6, 0x34, 0x54, 0x6e, 0x08, 0xe5, 0x6b, 0x84, 0xaa, 0x95, 0xf7,
-	0x4c, 0x3f, 0x20, 0x7f, 0x68, 0x50, 0x2b, 0xf4, 0x15, 0x72, 0xab, 0x80, 0x3d, 0xd7, 0xcd, 0xe8,
-	0xfe, 0x8c, 0x59, 0x25, 0x97, 0x2d, 0x16, 0x34, 0x3b, 0xc1, 0x15, 0xf7, 0x9c, 0xfc, 0xac, 0xc1,
-	0xd5, 0x8c, 0x1b, 0x91, 0x8d, 0x34, 0x69, 0x9e, 0xa3, 0xd1, 0xcd, 0x0a, 0xc8, 0x92, 0xb6, 0x94,
-	0x92, 0x5c, 0xcc, 0x0b, 0x8e, 0xc9, 0x2f, 0x1a, 0x5c, 0x53, 0x18, 0x12, 0xc9, 0x34, 0x5b, 0xbe,
-	0xdd, 0xd1, 0xed, 0x4a, 0x58, 0x54, 0x76, 0x43, 0x28, 0xd3, 0xc9, 0x9a, 0xfa, 0xb2, 0x70, 0x5a,
-	0xc2, 0x9d, 0x92, 0xb0, 0xa7, 0xec, 0x4e, 0x51, 0x19, 0x5e, 0x76, 0xa7, 0x28, 0x3d, 0x2e, 0x77,
-	0xa7, 0x48, 0x11, 0xd1, 0xec, 0x92, 0xdf, 0x34, 0x58, 0x52, 0x19, 0x12, 0xd9, 0x56, 0x35, 0x4a,
-	0x8e, 0xe9, 0xd1, 0x9d, 0x6a, 0x60, 0x94, 0xb6, 0x27, 0xa4, 0xed, 0x90, 0xad, 0x94, 0x34, 0xd7,
-	0x33, 0x3b, 0x3d, 0xce, 0x84, 0xd9, 0x89, 0x4b, 0x9a, 0x6a, 0xac, 0xef, 0x01, 0x26, 0xbb, 0x80,
-	0xac, 0x97, 0xee, 0x09, 0x6a, 0x14, 0x41, 0xaa, 0x0f, 0x62, 0xeb, 0x93, 0x97, 0x67, 0xba, 0x76,
-	0x7a, 0xa6, 0x6b, 0xff, 0x9c, 0xe9, 0xda, 0x4f, 0xe7, 0xfa, 0xdc, 0xe9, 0xb9, 0x3e, 0xf7, 0xd7,
-	0xb9, 0x3e, 0xf7, 0x35, 0xb3, 0xec, 0xe0, 0x68, 0xd8, 0x6e, 0x76, 0xdc, 0x3e, 0x7b, 0x28, 0xf2,
-	0x0f, 0xb9, 0xd9, 0x67, 0xfd, 0xa3, 0x61, 0x9b, 0x75, 0x8e, 0x4c, 0xdb, 0x61, 0xc7, 0x51, 0x5d,
-	0xf1, 0x6f, 0xb6, 0xbd, 0x20, 0xfe, 0x6d, 0xbe, 0xff, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xee,
-	0xee, 0x0c, 0x96, 0x22, 0x0f, 0x00, 0x00,
+	// 1356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xc1, 0x6f, 0x1b, 0xc5,
+	0x17, 0xce, 0xb8, 0x6d, 0xd2, 0xbe, 0xaa, 0xbf, 0x1f, 0x9d, 0x26, 0x4d, 0x98, 0xc6, 0x6b, 0x67,
+	0x55, 0x37, 0x89, 0x13, 0x3c, 0x75, 0x48, 0x5a, 0x21, 0x21, 0x24, 0xdc, 0x36, 0x05, 0x41, 0x4b,
+	0x70, 0xa3, 0x0a, 0x21, 0x41, 0xb5, 0xb6, 0x27, 0xeb, 0x95, 0xe2, 0x5d, 0x77, 0x77, 0x6d, 0x6c,
+	0x59, 0x11, 0x12, 0x48, 0x9c, 0x38, 0x80, 0xca, 0x85, 0x03, 0x47, 0x24, 0x6e, 0x20, 0xe0, 0xc2,
+	0x7f, 0xd0, 0x63, 0x25, 0x2e, 0x9c, 0x10, 0x4a, 0xf8, 0x1f, 0xb8, 0x22, 0xcf, 0xcc, 0xae, 0xbd,
+	0xeb, 0x5d, 0xaf, 0x8d, 0x7a, 0xe8, 0x29, 0xde, 0x37, 0xdf, 0x7b, 0xdf, 0xb7, 0x6f, 0xdf, 0xcc,
+	0x7c, 0x0a, 0x2c, 0x34, 0x0c, 0xd3, 0x65, 0x36, 0x6d, 0x17, 0xe9, 0xe3, 0x16, 0xb3, 0xbb, 0x85,
+	0xa6, 0x6d, 0xb9, 0x16, 0x3e, 0x27, 0xc2, 0x85, 0x76, 0x91, 0x2c, 0x0e, 0x10, 0x3a, 0x33, 0x99,
+	0x63, 0x38, 0x02, 0x43, 0x86, 0x52, 0xdd, 0x6e, 0x93, 0x79, 0xe1, 0xf9, 0x41, 0xb8, 0xe1, 0xe8,
+	0x11, 0xe0, 0x8a, 0xe6, 0x56, 0xeb, 0xa3, 0xe0, 0xa6, 0x65, 0x1d, 0xca, 0x68, 0xbe, 0x6a, 0x39,
+	0x0d, 0xcb, 0xa1, 0x15, 0xcd, 0x61, 0x42, 0x16, 0x6d, 0x17, 0x2b, 0xcc, 0xd5, 0x8a, 0xb4, 0xa9,
+	0xe9, 0x86, 0xa9, 0xb9, 0x86, 0x65, 0x4a, 0xec, 0xb2, 0x6e, 0x59, 0xfa, 0x21, 0xa3, 0x5a, 0xd3,
+	0xa0, 0x9a, 0x69, 0x5a, 0x2e, 0x5f, 0xf4, 0xc5, 0xe8, 0x96, 0x6e, 0xf1, 0x9f, 0xb4, 0xff, 0x4b,
+	0x44, 0xd5, 0x79, 0xc0, 0xef, 0xf7, 0xab, 0xee, 0x69, 0xb6, 0xd6, 0x70, 0xca, 0xec, 0x71, 0x8b,
+	0x39, 0xae, 0xba, 0x0b, 0x97, 0x02, 0x51, 0xa7, 0x69, 0x99, 0x0e, 0xc3, 0x14, 0x66, 0x9b, 0x3c,
+	0xb2, 0x84, 0xb2, 0x68, 0xed, 0xfc, 0xd6, 0xc5, 0x82, 0xdf, 0x9b, 0x82, 0x80, 0x96, 0x4e, 0x3f,
+	0xfd, 0x33, 0x33, 0x53, 0x96, 0x30, 0xf5, 0x0a, 0xbc, 0xcc, 0xeb, 0xdc, 0x6a, 0xd9, 0x36, 0x33,
+	0xdd, 0x87, 0xda, 0xa1, 0xc3, 0x5c, 0x8f, 0xe4, 0x2e, 0x90, 0xa8, 0x45, 0xc9, 0xb5, 0x0e, 0xb3,
+	0x6d, 0x1e, 0x89, 0xe0, 0x92, 0x50, 0x09, 0x50, 0x8b, 0x92, 0x25, 0x50, 0x5e, 0xfe, 0xc1, 0xf3,
+	0x70, 0xc6, 0xb4, 0xcc, 0x2a, 0xe3, 0x65, 0x4e, 0x97, 0xc5, 0x83, 0xcf, 0x1d, 0x4a, 0x99, 0x9e,
+	0xfb, 0x9d, 0x00, 0xf7, 0x2d, 0xcb, 0x3c, 0x30, 0xec, 0xc6, 0x58, 0x6e, 0xbc, 0x04, 0x73, 0x5a,
+	0xad, 0x66, 0x33, 0xc7, 0x59, 0x4a, 0x65, 0xd1, 0xda, 0xb9, 0xb2, 0xf7, 0xa8, 0x3e, 0x00, 0x12,
+	0x55, 0x4c, 0xaa, 0xda, 0x81, 0xb9, 0xaa, 0x08, 0x49, 0x59, 0x57, 0x86, 0x64, 0xdd, 0x73, 0xf4,
+	0x60, 0x96, 0x87, 0x55, 0x5f, 0x83, 0x95, 0xd1, 0xa2, 0x4e, 0xa9, 0x7b, 0xbf, 0x2f, 0x66, 0x7c,
+	0x97, 0x3e, 0x02, 0x75, 0x5c, 0xaa, 0xd4, 0x75, 0x13, 0xce, 0x4a, 0xae, 0xfe, 0x5c, 0x9c, 0x4a,
+	0x12, 0xe6, 0x83, 0xd5, 0x2c, 0x28, 0xbc, 0xfc, 0xbb, 0x9a, 0x13, 0x1c, 0x0d, 0x7f, 0x0e, 0xef,
+	0x43, 0x26, 0x16, 0x21, 0xd9, 0x37, 0x60, 0x4e, 0x7c, 0x0a, 0x8f, 0x3c, 0xe2, 0x63, 0x79, 0x08,
+	0x75, 0x09, 0x2e, 0x47, 0xd7, 0x53, 0x6f, 0xc3, 0xe2, 0xc8, 0xca, 0xf4, 0xd3, 0xb0, 0x0b, 0x79,
+	0xbf, 0xca, 0x1e, 0x33, 0x6b, 0x86, 0xa9, 0x07, 0x68, 0x4a, 0xdd, 0x37, 0x6b, 0x35, 0xdb, 0x6b,
+	0xfa, 0xd0, 0x20, 0xa0, 0xe0, 0x20, 0x7c, 0x00, 0x1b, 0x13, 0xd5, 0x99, 0x5e, 0xe1, 0x1d, 0x58,
+	0x0f, 0x57, 0x2e, 0xf5, 0x0f, 0xa1, 0x29, 0x05, 0x7e, 0x0c, 0xf9, 0x49, 0xca, 0x48, 0x7d, 0xd7,
+	0xe1, 0x0c, 0x3f, 0xe9, 0xa4, 0x3c, 0x32, 0x24, 0xef, 0xbd, 0x96, 0xab, 0x5b, 0x86, 0xa9, 0xef,
+	0x77, 0x44, 0xbe, 0x00, 0xaa, 0x19, 0x48, 0xf3, 0xfa, 0xa1, 0x65, 0xe6, 0x4f, 0xc6, 0x43, 0x50,
+	0xe2, 0x00, 0x92, 0x74, 0x1b, 0xe6, 0x2a, 0x22, 0x24, 0x07, 0x63, 0x1c, 0xad, 0x07, 0x55, 0x6f,
+	0xca, 0x89, 0x0b, 0xbe, 0xcd, 0x04, 0x7b, 0x65, 0x1f, 0xb2, 0xf1, 0x89, 0xff, 0xb9, 0x0f, 0xde,
+	0xd1, 0xc6, 0x83, 0xde, 0x06, 0x4c, 0x12, 0x42, 0xa2, 0x52, 0xa4, 0x84, 0x1b, 0x23, 0x9b, 0x95,
+	0x04, 0x37, 0xab, 0xcc, 0x10, 0x2a, 0x06, 0x7b, 0xf5, 0x75, 0xc8, 0xfa, 0x1f, 0xfc, 0x4e, 0x9b,
+	0x99, 0x2e, 0x7f, 0xb3, 0x49, 0xc7, 0xe5, 0x36, 0xac, 0x8c, 0xc9, 0x96, 0xd2, 0x32, 0x70, 0x9e,
+	0xf5, 0xd7, 0x1e, 0x0d, 0xbf, 0x14, 0x30, 0x1f, 0xae, 0x5a, 0xf0, 0x7f, 0x39, 0x6b, 0xfb, 0xb6,
+	0x66, 0x3a, 0x07, 0xcc, 0xc6, 0xff, 0x83, 0x94, 0x51, 0x93, 0xd0, 0x94, 0x51, 0xc3, 0x1b, 0x90,
+	0x72, 0x3b, 0xfc, 0x58, 0x3d, 0xbf, 0xb5, 0x10, 0xdd, 0x5e, 0x71, 0x43, 0xa5, 0xdc, 0x4e, 0x9f,
+	0x90, 0x77, 0x59, 0x12, 0x9e, 0x12, 0x84, 0x3c, 0x24, 0x08, 0xbf, 0x40, 0x70, 0x55, 0xdc, 0x83,
+	0x41, 0x5a, 0xa7, 0xd4, 0x7d, 0xc0, 0xcc, 0x1a, 0xf3, 0xdf, 0xfc, 0x32, 0xcc, 0x3a, 0x3c, 0x20,
+	0x5f, 0x5c, 0x3e, 0xe1, 0x5d, 0x80, 0xc1, 0x2d, 0x2d, 0x65, 0x5d, 0x2b, 0x88, 0x2b, 0xbd, 0xd0,
+	0xbf, 0xd2, 0x0b, 0xc2, 0x69, 0xc8, 0x2b, 0xbd, 0xb0, 0xa7, 0xe9, 0xde, 0x98, 0x95, 0x87, 0x32,
+	0xd5, 0x9f, 0x10, 0xe4, 0x12, 0x84, 0xc8, 0x26, 0xbe, 0x01, 0xe7, 0x5c, 0x6f, 0x31, 0xe2, 0x03,
+	0x87, 0xf2, 0x65, 0x33, 0x06, 0x29, 0xf8, 0x6e, 0x84, 0xe2, 0xd5, 0x44, 0xc5, 0x82, 0x3c, 0x20,
+	0xf9, 0x4b, 0x04, 0xd7, 0x62, 0x24, 0x97, 0x59, 0x95, 0x19, 0xed, 0x41, 0xf7, 0x08, 0x9c, 0xb5,
+	0x65, 0x48, 0xf6, 0xcf, 0x7f, 0x7e, 0x6e, 0x1d, 0xfc, 0x19, 0xc1, 0x6a, 0xa2, 0x9c, 0x17, 0xad,
+	0x87, 0x9f, 0xc2, 0x32, 0xd7, 0xec, 0x53, 0x75, 0xf7, 0x3b, 0x6f, 0x69, 0x8e, 0x77, 0xbc, 0xe0,
+	0x45, 0x98, 0x73, 0x3b, 0x8f, 0xea, 0x9a, 0x53, 0xf7, 0xe6, 0xce, 0xe5, 0xeb, 0xcf, 0xad, 0x6b,
+	0x3f, 0x20, 0x48, 0xc7, 0x28, 0x78, 0xc1, 0x7a, 0xb5, 0xf5, 0x0f, 0x86, 0x33, 0x5c, 0x2a, 0x3e,
+	0x80, 0x59, 0x61, 0x46, 0x71, 0x7a, 0x48, 0xc9, 0xa8, 0xcb, 0x25, 0x4a, 0xdc, 0xb2, 0x28, 0xaf,
+	0xa6, 0x3f, 0xfb, 0xfd, 0xef, 0x27, 0xa9, 0x45, 0xbc, 0x40, 0x7d, 0x6b, 0xde, 0x17, 0x41, 0x85,
+	0xb9, 0xc5, 0x9f, 0x23, 0xb8, 0x10, 0xf0, 0xae, 0xf8, 0x6a, 0xb8, 0x60, 0x94, 0xef, 0x25, 0xb9,
+	0x04, 0x94, 0x64, 0xcf, 0x71, 0xf6, 0x0c, 0x4e, 0x87, 0xd8, 0xc5, 0x45, 0x4e, 0xab, 0x22, 0x09,
+	0xf7, 0xe0, 0x42, 0xa0, 0xfc, 0xa8, 0x88, 0x28, 0x5b, 0x4c, 0x72, 0x09, 0xa8, 0x84, 0x16, 0x08,
+	0x11, 0xbc, 0x05, 0x01, 0x77, 0x17, 0xc7, 0x1e, 0x34, 0xc6, 0x24, 0x97, 0x80, 0x9a, 0xb0, 0x05,
+	0x92, 0xf3, 0x3b, 0x04, 0x0b, 0x91, 0x16, 0x15, 0x6f, 0x8e, 0xe5, 0x09, 0x99, 0x60, 0xf2, 0xca,
+	0x84, 0x68, 0xa9, 0x6e, 0x95, 0xab, 0x5b, 0xc1, 0x99, 0x90, 0x3a, 0xef, 0xce, 0xa4, 0x3d, 0x7e,
+	0xaf, 0x1c, 0xe1, 0x27, 0x08, 0xf0, 0xa8, 0x83, 0xc5, 0xeb, 0x61, 0xba, 0x58, 0x1f, 0x4c, 0xf2,
+	0x93, 0x40, 0xa5, 0xac, 0x6b, 0x5c, 0x56, 0x16, 0x2b, 0xd1, 0x4d, 0xb3, 0x3d, 0xfa, 0x5f, 0x10,
+	0x28, 0xe3, 0xfd, 0x25, 0xde, 0x89, 0xa2, 0x4d, 0xf4, 0xb5, 0xe4, 0xc6, 0xb4, 0x69, 0x52, 0xb9,
+	0xca, 0x95, 0x2f, 0x63, 0x12, 0xad, 0xfc, 0x50, 0x73, 0x5c, 0xfc, 0x2b, 0x82, 0xf4, 0x58, 0xd3,
+	0x89, 0xb7, 0xc7, 0xb0, 0xc7, 0x5a, 0x5d, 0xb2, 0x33, 0x65, 0x56, 0x42, 0xb3, 0xb9, 0x89, 0xa0,
+	0x3d, 0xe9, 0x7f, 0x8e, 0xf0, 0xd7, 0x08, 0x2e, 0x8e, 0x58, 0x55, 0xbc, 0x16, 0x26, 0x8d, 0xb3,
+	0xbb, 0x64, 0x7d, 0x02, 0x64, 0xc2, 0x58, 0x0a, 0x49, 0x96, 0xcc, 0x73, 0x3b, 0xf8, 0x1b, 0x04,
+	0x97, 0x22, 0xdc, 0x2a, 0x1e, 0x19, 0xb6, 0x78, 0x2f, 0x4c, 0x36, 0x26, 0xc2, 0x4a, 0x65, 0x57,
+	0xb9, 0x32, 0x05, 0x2f, 0x47, 0x37, 0x4b, 0xee, 0x96, 0xfe, 0x99, 0x12, 0xf0, 0xae, 0xa3, 0x67,
+	0x4a, 0x94, 0x1b, 0x26, 0xb9, 0x04, 0x54, 0xc2, 0x99, 0x22, 0x44, 0x78, 0x7b, 0x17, 0x7f, 0x8f,
+	0x60, 0x3e, 0xca, 0xad, 0xe2, 0x8d, 0xa8, 0x41, 0x89, 0x71, 0xc4, 0x64, 0x73, 0x32, 0xb0, 0x94,
+	0xb6, 0xc5, 0xa5, 0x6d, 0xe2, 0x7c, 0x48, 0x9a, 0x65, 0x6b, 0xd5, 0x43, 0x46, 0xb9, 0x13, 0xe6,
+	0x4d, 0x1a, 0x1a, 0xac, 0x4f, 0x00, 0x06, 0x67, 0x01, 0x5e, 0x49, 0x3c, 0x27, 0x88, 0x3a, 0x0e,
+	0x32, 0xc5, 0x46, 0xfc, 0x11, 0xc1, 0x52, 0x9c, 0x1b, 0xc5, 0x74, 0xe4, 0x66, 0x1d, 0x6f, 0xa0,
+	0xc9, 0xf5, 0xc9, 0x13, 0xa4, 0xc6, 0xeb, 0x5c, 0x63, 0x1e, 0xaf, 0x85, 0x34, 0xfa, 0xd6, 0x82,
+	0x0a, 0x0f, 0x4e, 0x7b, 0xe2, 0xef, 0x11, 0xfe, 0x0d, 0x01, 0x89, 0x77, 0x7f, 0xb8, 0x98, 0x2c,
+	0x21, 0x64, 0x5c, 0xc9, 0xd6, 0x34, 0x29, 0x52, 0xf7, 0x36, 0xd7, 0x5d, 0xc0, 0x9b, 0xb1, 0xba,
+	0x3d, 0xef, 0x4b, 0x7b, 0xde, 0xaf, 0x23, 0xfc, 0x2d, 0x82, 0x97, 0xc2, 0x1e, 0x0c, 0xaf, 0x86,
+	0xe9, 0x63, 0x7c, 0x22, 0x59, 0x4b, 0x06, 0x26, 0x8c, 0xe0, 0x40, 0x9d, 0x34, 0x9c, 0xb4, 0x27,
+	0x7f, 0x1c, 0x95, 0xde, 0x7e, 0x7a, 0xac, 0xa0, 0x67, 0xc7, 0x0a, 0xfa, 0xeb, 0x58, 0x41, 0x5f,
+	0x9d, 0x28, 0x33, 0xcf, 0x4e, 0x94, 0x99, 0x3f, 0x4e, 0x94, 0x99, 0x0f, 0xa9, 0x6e, 0xb8, 0xf5,
+	0x56, 0xa5, 0x50, 0xb5, 0x1a, 0xf4, 0x1e, 0xaf, 0xb7, 0xcf, 0xb4, 0x06, 0x6d, 0xd4, 0x5b, 0x15,
+	0x5a, 0xad, 0x6b, 0x86, 0x49, 0x3b, 0x1e, 0x0f, 0xff, 0xb7, 0x69, 0x65, 0x96, 0xff, 0x53, 0xf2,
+	0xd5, 0x7f, 0x07, 0x00, 0x9f, 0x96, 0xdf, 0x79, 0x8b, 0x15, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1282,6 +1688,9 @@ type QueryClient interface {
 	BatchConfirms(ctx context.Context, in *QueryBatchConfirmsRequest, opts ...grpc.CallOption) (*QueryBatchConfirmsResponse, error)
 	LastEventNonceByAddr(ctx context.Context, in *QueryLastEventNonceByAddrRequest, opts ...grpc.CallOption) (*QueryLastEventNonceByAddrResponse, error)
 	LastValset(ctx context.Context, in *QueryLastValsetRequest, opts ...grpc.CallOption) (*QueryLastValsetResponse, error)
+	PendingTransfersBySender(ctx context.Context, in *QueryPendingTransfersBySenderRequest, opts ...grpc.CallOption) (*QueryPendingTransfersBySenderResponse, error)
+	PendingTransfersByReceiver(ctx context.Context, in *QueryPendingTransfersByReceiverRequest, opts ...grpc.CallOption) (*QueryPendingTransfersByReceiverResponse, error)
+	TransferByTxHash(ctx context.Context, in *QueryTransferByTxHashRequest, opts ...grpc.CallOption) (*QueryTransferByTxHashResponse, error)
 }
 
 type queryClient struct {
@@ -1409,6 +1818,33 @@ func (c *queryClient) LastValset(ctx context.Context, in *QueryLastValsetRequest
 	return out, nil
 }
 
+func (c *queryClient) PendingTransfersBySender(ctx context.Context, in *QueryPendingTransfersBySenderRequest, opts ...grpc.CallOption) (*QueryPendingTransfersBySenderResponse, error) {
+	out := new(QueryPendingTransfersBySenderResponse)
+	err := c.cc.Invoke(ctx, "/minter.v1.Query/PendingTransfersBySender", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTransfersByReceiver(ctx context.Context, in *QueryPendingTransfersByReceiverRequest, opts ...grpc.CallOption) (*QueryPendingTransfersByReceiverResponse, error) {
+	out := new(QueryPendingTransfersByReceiverResponse)
+	err := c.cc.Invoke(ctx, "/minter.v1.Query/PendingTransfersByReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TransferByTxHash(ctx context.Context, in *QueryTransferByTxHashRequest, opts ...grpc.CallOption) (*QueryTransferByTxHashResponse, error) {
+	out := new(QueryTransferByTxHashResponse)
+	err := c.cc.Invoke(ctx, "/minter.v1.Query/TransferByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Deployments queries deployments
@@ -1425,6 +1861,9 @@ type QueryServer interface {
 	BatchConfirms(context.Context, *QueryBatchConfirmsRequest) (*QueryBatchConfirmsResponse, error)
 	LastEventNonceByAddr(context.Context, *QueryLastEventNonceByAddrRequest) (*QueryLastEventNonceByAddrResponse, error)
 	LastValset(context.Context, *QueryLastValsetRequest) (*QueryLastValsetResponse, error)
+	PendingTransfersBySender(context.Context, *QueryPendingTransfersBySenderRequest) (*QueryPendingTransfersBySenderResponse, error)
+	PendingTransfersByReceiver(context.Context, *QueryPendingTransfersByReceiverRequest) (*QueryPendingTransfersByReceiverResponse, error)
+	TransferByTxHash(context.Context, *QueryTransferByTxHashRequest) (*QueryTransferByTxHashResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1470,6 +1909,15 @@ func (*UnimplementedQueryServer) LastEventNonceByAddr(ctx context.Context, req *
 func (*UnimplementedQueryServer) LastValset(ctx context.Context, req *QueryLastValsetRequest) (*QueryLastValsetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastValset not implemented")
 }
+func (*UnimplementedQueryServer) PendingTransfersBySender(ctx context.Context, req *QueryPendingTransfersBySenderRequest) (*QueryPendingTransfersBySenderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTransfersBySender not implemented")
+}
+func (*UnimplementedQueryServer) PendingTransfersByReceiver(ctx context.Context, req *QueryPendingTransfersByReceiverRequest) (*QueryPendingTransfersByReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTransfersByReceiver not implemented")
+}
+func (*UnimplementedQueryServer) TransferByTxHash(ctx context.Context, req *QueryTransferByTxHashRequest) (*QueryTransferByTxHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferByTxHash not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1709,18 +2157,72 @@ func _Query_LastValset_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "minter.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "CurrentValset",
-			Handler:    _Query_CurrentValset_Handler,
-		},
+func _Query_PendingTransfersBySender_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTransfersBySenderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTransfersBySender(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/minter.v1.Query/PendingTransfersBySender",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTransfersBySender(ctx, req.(*QueryPendingTransfersBySenderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTransfersByReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTransfersByReceiverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTransfersByReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/minter.v1.Query/PendingTransfersByReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTransfersByReceiver(ctx, req.(*QueryPendingTransfersByReceiverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TransferByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTransferByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TransferByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/minter.v1.Query/TransferByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TransferByTxHash(ctx, req.(*QueryTransferByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "minter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "CurrentValset",
+			Handler:    _Query_CurrentValset_Handler,
+		},
 		{
 			MethodName: "ValsetRequest",
 			Handler:    _Query_ValsetRequest_Handler,
@@ -1765,6 +2267,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastValset",
 			Handler:    _Query_LastValset_Handler,
 		},
+		{
+			MethodName: "PendingTransfersBySender",
+			Handler:    _Query_PendingTransfersBySender_Handler,
+		},
+		{
+			MethodName: "PendingTransfersByReceiver",
+			Handler:    _Query_PendingTransfersByReceiver_Handler,
+		},
+		{
+			MethodName: "TransferByTxHash",
+			Handler:    _Query_TransferByTxHash_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "minter/v1/query.proto",
@@ -2576,174 +3090,363 @@ func (m *QueryLastEventNonceByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *PendingTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentValsetRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *PendingTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryCurrentValsetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.BatchNonce != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
 	}
-	var l int
-	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValsetRequestRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+func (m *QueryPendingTransfersBySenderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValsetRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Valset != nil {
-		l = m.Valset.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPendingTransfersBySenderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValsetConfirmRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingTransfersBySenderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryValsetConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Confirm != nil {
-		l = m.Confirm.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPendingTransfersBySenderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryValsetConfirmsByNonceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
-	}
-	return n
+func (m *QueryPendingTransfersBySenderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryValsetConfirmsByNonceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingTransfersBySenderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Confirms) > 0 {
-		for _, e := range m.Confirms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLastValsetRequestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingTransfersByReceiverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryLastValsetRequestsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPendingTransfersByReceiverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTransfersByReceiverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Valsets) > 0 {
-		for _, e := range m.Valsets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTransfersByReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTransfersByReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTransfersByReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferByTxHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferByTxHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferByTxHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTransferByTxHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTransferByTxHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTransferByTxHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Transfers) > 0 {
+		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Transfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	return n
 }
 
-func (m *QueryLastValsetRequest) Size() (n int) {
+func (m *QueryParamsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryLastValsetResponse) Size() (n int) {
+func (m *QueryCurrentValsetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentValsetResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2756,20 +3459,19 @@ func (m *QueryLastValsetResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryLastPendingValsetRequestByAddrRequest) Size() (n int) {
+func (m *QueryValsetRequestRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
 	}
 	return n
 }
 
-func (m *QueryLastPendingValsetRequestByAddrResponse) Size() (n int) {
+func (m *QueryValsetRequestResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2782,12 +3484,15 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryLastPendingBatchRequestByAddrRequest) Size() (n int) {
+func (m *QueryValsetConfirmRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
 	l = len(m.Address)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -2795,36 +3500,39 @@ func (m *QueryLastPendingBatchRequestByAddrRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryLastPendingBatchRequestByAddrResponse) Size() (n int) {
+func (m *QueryValsetConfirmResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
+	if m.Confirm != nil {
+		l = m.Confirm.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryOutgoingTxBatchesRequest) Size() (n int) {
+func (m *QueryValsetConfirmsByNonceRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
 	return n
 }
 
-func (m *QueryOutgoingTxBatchesResponse) Size() (n int) {
+func (m *QueryValsetConfirmsByNonceResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Batches) > 0 {
-		for _, e := range m.Batches {
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
@@ -2832,59 +3540,79 @@ func (m *QueryOutgoingTxBatchesResponse) Size() (n int) {
 	return n
 }
 
-func (m *QueryBatchRequestByNonceRequest) Size() (n int) {
+func (m *QueryLastValsetRequestsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	return n
+}
+
+func (m *QueryLastValsetRequestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Valsets) > 0 {
+		for _, e := range m.Valsets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *QueryBatchRequestByNonceResponse) Size() (n int) {
+func (m *QueryLastValsetRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Batch != nil {
-		l = m.Batch.Size()
+	return n
+}
+
+func (m *QueryLastValsetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valset != nil {
+		l = m.Valset.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryBatchConfirmsRequest) Size() (n int) {
+func (m *QueryLastPendingValsetRequestByAddrRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovQuery(uint64(m.Nonce))
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryBatchConfirmsResponse) Size() (n int) {
+func (m *QueryLastPendingValsetRequestByAddrResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Confirms) > 0 {
-		for _, e := range m.Confirms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Valset != nil {
+		l = m.Valset.Size()
+		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryLastEventNonceByAddrRequest) Size() (n int) {
+func (m *QueryLastPendingBatchRequestByAddrRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -2894,28 +3622,890 @@ func (m *QueryLastEventNonceByAddrRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *QueryLastPendingBatchRequestByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutgoingTxBatchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOutgoingTxBatchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatchRequestByNonceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBatchRequestByNonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Batch != nil {
+		l = m.Batch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchConfirmsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovQuery(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *QueryBatchConfirmsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Confirms) > 0 {
+		for _, e := range m.Confirms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastEventNonceByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLastEventNonceByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovQuery(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *PendingTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BatchNonce != 0 {
+		n += 1 + sovQuery(uint64(m.BatchNonce))
+	}
+	return n
+}
+
+func (m *QueryPendingTransfersBySenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTransfersBySenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTransfersByReceiverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTransfersByReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferByTxHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTransferByTxHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Transfers) > 0 {
+		for _, e := range m.Transfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentValsetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentValsetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetRequestRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
+			}
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfirmRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfirmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryLastEventNonceByAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovQuery(uint64(m.EventNonce))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValsetConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Confirm == nil {
+				m.Confirm = &MsgValsetConfirm{}
+			}
+			if err := m.Confirm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2938,12 +4528,31 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2968,7 +4577,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2991,15 +4600,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3026,7 +4635,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Confirms = append(m.Confirms, &MsgValsetConfirm{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3054,7 +4664,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3077,10 +4687,10 @@ func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentValsetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3107,7 +4717,7 @@ func (m *QueryCurrentValsetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3130,15 +4740,15 @@ func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentValsetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3165,10 +4775,8 @@ func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
-			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Valsets = append(m.Valsets, &Valset{})
+			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3196,7 +4804,7 @@ func (m *QueryCurrentValsetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastValsetRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3219,31 +4827,12 @@ func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetRequestRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastValsetRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetRequestRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3268,7 +4857,7 @@ func (m *QueryValsetRequestRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastValsetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3291,10 +4880,10 @@ func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastValsetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3357,7 +4946,7 @@ func (m *QueryValsetRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3380,32 +4969,13 @@ func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
@@ -3461,7 +5031,7 @@ func (m *QueryValsetConfirmRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3484,15 +5054,15 @@ func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirm", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3519,10 +5089,10 @@ func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Confirm == nil {
-				m.Confirm = &MsgValsetConfirm{}
+			if m.Valset == nil {
+				m.Valset = &Valset{}
 			}
-			if err := m.Confirm.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3550,7 +5120,7 @@ func (m *QueryValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3573,17 +5143,17 @@ func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3593,11 +5163,24 @@ func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3622,7 +5205,7 @@ func (m *QueryValsetConfirmsByNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3645,15 +5228,15 @@ func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValsetConfirmsByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3680,8 +5263,10 @@ func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirms = append(m.Confirms, &MsgValsetConfirm{})
-			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Batch == nil {
+				m.Batch = &OutgoingTxBatch{}
+			}
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3709,7 +5294,7 @@ func (m *QueryValsetConfirmsByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3732,10 +5317,10 @@ func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3762,7 +5347,7 @@ func (m *QueryLastValsetRequestsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3785,15 +5370,15 @@ func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValsetRequestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valsets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3820,8 +5405,8 @@ func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Valsets = append(m.Valsets, &Valset{})
-			if err := m.Valsets[len(m.Valsets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Batches = append(m.Batches, &OutgoingTxBatch{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3849,7 +5434,7 @@ func (m *QueryLastValsetRequestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValsetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3872,12 +5457,31 @@ func (m *QueryLastValsetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValsetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValsetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3902,7 +5506,7 @@ func (m *QueryLastValsetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastValsetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3925,15 +5529,15 @@ func (m *QueryLastValsetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastValsetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastValsetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3960,10 +5564,10 @@ func (m *QueryLastValsetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
+			if m.Batch == nil {
+				m.Batch = &OutgoingTxBatch{}
 			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3991,7 +5595,7 @@ func (m *QueryLastValsetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4014,17 +5618,17 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchConfirmsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			var stringLen uint64
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4034,24 +5638,11 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4076,7 +5667,7 @@ func (m *QueryLastPendingValsetRequestByAddrRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4099,15 +5690,15 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchConfirmsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingValsetRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Valset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4134,10 +5725,8 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Valset == nil {
-				m.Valset = &Valset{}
-			}
-			if err := m.Valset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Confirms = append(m.Confirms, &MsgConfirmBatch{})
+			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4165,7 +5754,7 @@ func (m *QueryLastPendingValsetRequestByAddrResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4188,10 +5777,10 @@ func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4250,7 +5839,7 @@ func (m *QueryLastPendingBatchRequestByAddrRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4273,17 +5862,17 @@ func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastPendingBatchRequestByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var msglen int
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4293,28 +5882,11 @@ func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Batch == nil {
-				m.Batch = &OutgoingTxBatch{}
-			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4339,7 +5911,7 @@ func (m *QueryLastPendingBatchRequestByAddrResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
+func (m *PendingTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4362,12 +5934,83 @@ func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4392,7 +6035,7 @@ func (m *QueryOutgoingTxBatchesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransfersBySenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4415,15 +6058,47 @@ func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransfersBySenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutgoingTxBatchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransfersBySenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4450,8 +6125,10 @@ func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Batches = append(m.Batches, &OutgoingTxBatch{})
-			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4479,7 +6156,7 @@ func (m *QueryOutgoingTxBatchesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransfersBySenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4502,17 +6179,17 @@ func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransfersBySenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransfersBySenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4522,67 +6199,29 @@ func (m *QueryBatchRequestByNonceRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if skippy < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Transfers = append(m.Transfers, PendingTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchRequestByNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Batch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4609,10 +6248,10 @@ func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Batch == nil {
-				m.Batch = &OutgoingTxBatch{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Batch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4640,7 +6279,7 @@ func (m *QueryBatchRequestByNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransfersByReceiverRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4663,17 +6302,17 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchConfirmsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransfersByReceiverRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchConfirmsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransfersByReceiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4683,11 +6322,60 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4712,7 +6400,7 @@ func (m *QueryBatchConfirmsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingTransfersByReceiverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4735,15 +6423,15 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBatchConfirmsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingTransfersByReceiverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBatchConfirmsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingTransfersByReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Confirms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4770,8 +6458,44 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Confirms = append(m.Confirms, &MsgConfirmBatch{})
-			if err := m.Confirms[len(m.Confirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Transfers = append(m.Transfers, PendingTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4799,7 +6523,7 @@ func (m *QueryBatchConfirmsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferByTxHashRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4822,15 +6546,15 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferByTxHashRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferByTxHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4858,7 +6582,43 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4884,7 +6644,7 @@ func (m *QueryLastEventNonceByAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTransferByTxHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4907,17 +6667,17 @@ func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTransferByTxHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastEventNonceByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTransferByTxHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
 			}
-			m.EventNonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4927,11 +6687,62 @@ func (m *QueryLastEventNonceByAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, PendingTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -141,6 +141,130 @@ func (m *Valset) GetMembers() []*BridgeValidator {
 	return nil
 }
 
+// SigningCheck lists the validators which were bonded when a valset or a batch was created. They have to sign it
+// within the signed window, the check is removed once it is done.
+type SigningCheck struct {
+	Nonce      uint64   `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Height     int64    `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Validators []string `protobuf:"bytes,3,rep,name=validators,proto3" json:"validators,omitempty"`
+}
+
+func (m *SigningCheck) Reset()         { *m = SigningCheck{} }
+func (m *SigningCheck) String() string { return proto.CompactTextString(m) }
+func (*SigningCheck) ProtoMessage()    {}
+func (*SigningCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b65e2e0e002eeb9, []int{2}
+}
+func (m *SigningCheck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SigningCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SigningCheck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SigningCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SigningCheck.Merge(m, src)
+}
+func (m *SigningCheck) XXX_Size() int {
+	return m.Size()
+}
+func (m *SigningCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_SigningCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SigningCheck proto.InternalMessageInfo
+
+func (m *SigningCheck) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *SigningCheck) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SigningCheck) GetValidators() []string {
+	if m != nil {
+		return m.Validators
+	}
+	return nil
+}
+
+// VoucherAccounting sums up the vouchers the module has minted and burned. Released are the burned vouchers which are
+// no longer pending in the outgoing pool, either executed on the other chain or refunded.
+type VoucherAccounting struct {
+	Minted   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	Burned   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+}
+
+func (m *VoucherAccounting) Reset()         { *m = VoucherAccounting{} }
+func (m *VoucherAccounting) String() string { return proto.CompactTextString(m) }
+func (*VoucherAccounting) ProtoMessage()    {}
+func (*VoucherAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2b65e2e0e002eeb9, []int{3}
+}
+func (m *VoucherAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherAccounting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoucherAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherAccounting.Merge(m, src)
+}
+func (m *VoucherAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherAccounting proto.InternalMessageInfo
+
+func (m *VoucherAccounting) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *VoucherAccounting) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *VoucherAccounting) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
 type ColdStorageTransferProposal struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
@@ -148,7 +272,7 @@ type ColdStorageTransferProposal struct {
 func (m *ColdStorageTransferProposal) Reset()      { *m = ColdStorageTransferProposal{} }
 func (*ColdStorageTransferProposal) ProtoMessage() {}
 func (*ColdStorageTransferProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2b65e2e0e002eeb9, []int{2}
+	return fileDescriptor_2b65e2e0e002eeb9, []int{4}
 }
 func (m *ColdStorageTransferProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,38 +304,46 @@ var xxx_messageInfo_ColdStorageTransferProposal proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*BridgeValidator)(nil), "minter.v1.BridgeValidator")
 	proto.RegisterType((*Valset)(nil), "minter.v1.Valset")
+	proto.RegisterType((*SigningCheck)(nil), "minter.v1.SigningCheck")
+	proto.RegisterType((*VoucherAccounting)(nil), "minter.v1.VoucherAccounting")
 	proto.RegisterType((*ColdStorageTransferProposal)(nil), "minter.v1.ColdStorageTransferProposal")
 }
 
 func init() { proto.RegisterFile("minter/v1/types.proto", fileDescriptor_2b65e2e0e002eeb9) }
 
 var fileDescriptor_2b65e2e0e002eeb9 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0xaf, 0xd3, 0x30,
-	0x10, 0xc7, 0xe3, 0xf7, 0xa0, 0xe8, 0xf9, 0x15, 0x90, 0xa2, 0x22, 0x85, 0x22, 0x25, 0xa5, 0x12,
-	0x52, 0x16, 0x6c, 0x02, 0x4c, 0x6c, 0xb4, 0x13, 0x03, 0x15, 0x0a, 0x55, 0x07, 0x16, 0xe4, 0x24,
-	0x26, 0x8d, 0x88, 0x7d, 0x91, 0xed, 0xb6, 0xf0, 0x0d, 0x18, 0x3b, 0x32, 0x76, 0xe6, 0x93, 0x74,
-	0xec, 0xc8, 0x04, 0xa8, 0x5d, 0xf8, 0x18, 0x28, 0x76, 0x8a, 0x10, 0x53, 0xee, 0xfe, 0x77, 0xf9,
-	0x9d, 0xef, 0x7f, 0xf8, 0x9e, 0xa8, 0xa4, 0xe1, 0x8a, 0xae, 0x13, 0x6a, 0x3e, 0x37, 0x5c, 0x93,
-	0x46, 0x81, 0x01, 0xff, 0xca, 0xc9, 0x64, 0x9d, 0x0c, 0xc3, 0x1c, 0xb4, 0x00, 0x4d, 0x33, 0xa6,
-	0x39, 0x5d, 0x27, 0x19, 0x37, 0x2c, 0xa1, 0x39, 0x54, 0xd2, 0xb5, 0x0e, 0x07, 0x25, 0x94, 0x60,
-	0x43, 0xda, 0x46, 0x4e, 0x1d, 0xcf, 0xf0, 0xdd, 0x89, 0xaa, 0x8a, 0x92, 0x2f, 0x58, 0x5d, 0x15,
-	0xcc, 0x80, 0xf2, 0x07, 0xf8, 0x66, 0x03, 0x1b, 0xae, 0x02, 0x34, 0x42, 0xf1, 0x8d, 0xd4, 0x25,
-	0xfe, 0x23, 0x7c, 0xc7, 0xcd, 0x7a, 0xcf, 0x8a, 0x42, 0x71, 0xad, 0x83, 0x8b, 0x11, 0x8a, 0xaf,
-	0xd2, 0xdb, 0x4e, 0x7d, 0xe9, 0xc4, 0xf1, 0x06, 0xf7, 0x16, 0xac, 0xd6, 0xdc, 0xb4, 0x18, 0x09,
-	0x32, 0xe7, 0x67, 0x8c, 0x4d, 0xfc, 0x87, 0xb8, 0xdf, 0x61, 0x5c, 0xf1, 0xc2, 0x16, 0xaf, 0x9d,
-	0x36, 0xb3, 0x2d, 0xcf, 0xf1, 0x2d, 0xc1, 0x45, 0xc6, 0x95, 0x0e, 0x2e, 0x47, 0x97, 0xf1, 0xf5,
-	0xd3, 0x21, 0xf9, 0xbb, 0x25, 0xf9, 0xef, 0xb1, 0xe9, 0xb9, 0x75, 0xbc, 0x45, 0xf8, 0xc1, 0x14,
-	0xea, 0xe2, 0xad, 0x01, 0xc5, 0x4a, 0x3e, 0x57, 0x4c, 0xea, 0x0f, 0x5c, 0xbd, 0x51, 0xd0, 0x80,
-	0x66, 0xb5, 0x9f, 0xe3, 0x1e, 0x13, 0xb0, 0x92, 0x26, 0x40, 0x16, 0x7a, 0x9f, 0x38, 0xbf, 0x48,
-	0xeb, 0x17, 0xe9, 0xfc, 0x22, 0x53, 0xa8, 0xe4, 0xe4, 0xc9, 0xfe, 0x47, 0xe4, 0x7d, 0xfb, 0x19,
-	0xc5, 0x65, 0x65, 0x96, 0xab, 0x8c, 0xe4, 0x20, 0x68, 0x67, 0xae, 0xfb, 0x3c, 0xd6, 0xc5, 0xc7,
-	0xee, 0x0c, 0xed, 0x0f, 0x3a, 0xed, 0xd0, 0x2f, 0xfa, 0x5f, 0x76, 0x91, 0xf7, 0x75, 0x17, 0x79,
-	0xbf, 0x77, 0x91, 0x37, 0x79, 0xb5, 0x3f, 0x86, 0xe8, 0x70, 0x0c, 0xd1, 0xaf, 0x63, 0x88, 0xb6,
-	0xa7, 0xd0, 0x3b, 0x9c, 0x42, 0xef, 0xfb, 0x29, 0xf4, 0xde, 0xd1, 0x7f, 0xc8, 0xaf, 0xed, 0x6e,
-	0x73, 0xce, 0x04, 0x15, 0xcb, 0x55, 0x46, 0xf3, 0x25, 0xab, 0x24, 0xfd, 0x44, 0xbb, 0x83, 0xdb,
-	0x31, 0x59, 0xcf, 0x5e, 0xeb, 0xd9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6f, 0x01, 0xaa, 0x16,
-	0x07, 0x02, 0x00, 0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x93, 0x16, 0x0a, 0xe7, 0x2b, 0x20, 0xa2, 0x03, 0x85, 0x22, 0xa5, 0x25, 0x12, 0x52,
+	0x17, 0x62, 0x0a, 0x4c, 0x6c, 0xd7, 0x4e, 0x0c, 0x9c, 0x50, 0xee, 0xd4, 0x01, 0x21, 0x21, 0xc7,
+	0x79, 0x38, 0xd6, 0x25, 0x76, 0x65, 0x3b, 0x3d, 0xf8, 0x06, 0x8c, 0x37, 0x32, 0x76, 0xe6, 0x83,
+	0xa0, 0x1b, 0x6f, 0x64, 0x02, 0xd4, 0x2e, 0x7c, 0x0c, 0x14, 0x3b, 0x3d, 0x9d, 0x90, 0xd8, 0xb8,
+	0xa9, 0x7e, 0x7f, 0xbf, 0xfe, 0xfe, 0xcf, 0xfe, 0xc7, 0xe8, 0x5e, 0xc5, 0x85, 0x01, 0x85, 0x97,
+	0x13, 0x6c, 0x3e, 0x2d, 0x40, 0x27, 0x0b, 0x25, 0x8d, 0x0c, 0x76, 0x9c, 0x9c, 0x2c, 0x27, 0x83,
+	0x88, 0x4a, 0x5d, 0x49, 0x8d, 0x33, 0xa2, 0x01, 0x2f, 0x27, 0x19, 0x18, 0x32, 0xc1, 0x54, 0x72,
+	0xe1, 0x5a, 0x07, 0x7b, 0x4c, 0x32, 0x69, 0x97, 0xb8, 0x59, 0x39, 0x35, 0x3e, 0x40, 0x77, 0xa6,
+	0x8a, 0xe7, 0x0c, 0xe6, 0xa4, 0xe4, 0x39, 0x31, 0x52, 0x05, 0x7b, 0xe8, 0xfa, 0x42, 0x9e, 0x80,
+	0x0a, 0xfd, 0x91, 0x3f, 0xbe, 0x96, 0xba, 0x22, 0x78, 0x8c, 0x6e, 0x3b, 0xaf, 0xf7, 0x24, 0xcf,
+	0x15, 0x68, 0x1d, 0x76, 0x46, 0xfe, 0x78, 0x27, 0xbd, 0xe5, 0xd4, 0x7d, 0x27, 0xc6, 0x27, 0xa8,
+	0x37, 0x27, 0xa5, 0x06, 0xd3, 0x60, 0x84, 0x14, 0x14, 0xb6, 0x18, 0x5b, 0x04, 0x8f, 0x50, 0xbf,
+	0xc5, 0xb8, 0xcd, 0x8e, 0xdd, 0xdc, 0x75, 0xda, 0x81, 0x6d, 0x79, 0x81, 0x6e, 0x54, 0x50, 0x65,
+	0xa0, 0x74, 0xd8, 0x1d, 0x75, 0xc7, 0xbb, 0xcf, 0x06, 0xc9, 0xc5, 0x29, 0x93, 0xbf, 0x86, 0x4d,
+	0xb7, 0xad, 0xf1, 0x3b, 0xd4, 0x3f, 0xe4, 0x4c, 0x70, 0xc1, 0x66, 0x05, 0xd0, 0xe3, 0x7f, 0xd8,
+	0xdf, 0x47, 0xbd, 0x02, 0x38, 0x2b, 0x8c, 0x35, 0xee, 0xa6, 0x6d, 0x15, 0x44, 0x08, 0x2d, 0xb7,
+	0x4c, 0x67, 0xbb, 0x93, 0x5e, 0x52, 0xe2, 0x6f, 0x1d, 0x74, 0x77, 0x2e, 0x6b, 0x5a, 0x80, 0xda,
+	0xa7, 0x54, 0xd6, 0xc2, 0x70, 0xc1, 0x02, 0x8a, 0x7a, 0x76, 0xb2, 0x3c, 0xf4, 0xed, 0xa0, 0x0f,
+	0x12, 0x97, 0x41, 0xd2, 0x64, 0x90, 0xb4, 0x19, 0x24, 0x33, 0xc9, 0xc5, 0xf4, 0xe9, 0xd9, 0x8f,
+	0xa1, 0xf7, 0xf5, 0xe7, 0x70, 0xcc, 0xb8, 0x29, 0xea, 0x2c, 0xa1, 0xb2, 0xc2, 0x6d, 0x60, 0xee,
+	0xe7, 0x89, 0xce, 0x8f, 0xdb, 0x68, 0x9b, 0x3f, 0xe8, 0xb4, 0x45, 0x37, 0x26, 0x59, 0xad, 0x04,
+	0xe4, 0x61, 0xe7, 0x0a, 0x4c, 0x1c, 0x3a, 0x60, 0xe8, 0xa6, 0x82, 0x12, 0x88, 0x86, 0x3c, 0xec,
+	0xfe, 0x7f, 0x9b, 0x0b, 0x78, 0x7c, 0xea, 0xa3, 0x87, 0x33, 0x59, 0xe6, 0x87, 0x46, 0x2a, 0xc2,
+	0xe0, 0x48, 0x11, 0xa1, 0x3f, 0x80, 0x7a, 0xa3, 0xe4, 0x42, 0x6a, 0x52, 0x36, 0xa7, 0x25, 0x55,
+	0x73, 0xbf, 0x57, 0x72, 0xa5, 0x0e, 0xfd, 0xb2, 0xff, 0x79, 0x35, 0xf4, 0xbe, 0xac, 0x86, 0xde,
+	0xef, 0xd5, 0xd0, 0x9b, 0xbe, 0x3a, 0x5b, 0x47, 0xfe, 0xf9, 0x3a, 0xf2, 0x7f, 0xad, 0x23, 0xff,
+	0x74, 0x13, 0x79, 0xe7, 0x9b, 0xc8, 0xfb, 0xbe, 0x89, 0xbc, 0xb7, 0xf8, 0x12, 0xf9, 0xb5, 0xfd,
+	0x04, 0x8f, 0x80, 0x54, 0xb8, 0x2a, 0xea, 0x0c, 0xd3, 0x82, 0x70, 0x81, 0x3f, 0xe2, 0xf6, 0x5d,
+	0x5a, 0x9b, 0xac, 0x67, 0x1f, 0xd5, 0xf3, 0x3f, 0x03, 0x00, 0x9d, 0x37, 0x3d, 0x59, 0xae, 0x03,
+	0x00, 0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -296,6 +428,113 @@ func (m *Valset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SigningCheck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SigningCheck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SigningCheck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Validators[iNdEx])
+			copy(dAtA[i:], m.Validators[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Validators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoucherAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ColdStorageTransferProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -381,6 +620,54 @@ func (m *Valset) Size() (n int) {
 	return n
 }
 
+func (m *SigningCheck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTypes(uint64(m.Nonce))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if len(m.Validators) > 0 {
+		for _, s := range m.Validators {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VoucherAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ColdStorageTransferProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -631,6 +918,284 @@ func (m *Valset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SigningCheck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SigningCheck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SigningCheck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VoucherAccounting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoucherAccounting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoucherAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ColdStorageTransferProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
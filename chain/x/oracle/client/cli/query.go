@@ -19,6 +19,9 @@ func GetQueryCmd(storeKey string) *cobra.Command {
 	}
 	peggyQueryCmd.AddCommand([]*cobra.Command{
 		CmdGetPrices(storeKey),
+		CmdGetPriceHistory(storeKey),
+		CmdGetTWAP(storeKey),
+		CmdGetVotingInfos(storeKey),
 	}...)
 
 	return peggyQueryCmd
@@ -45,3 +48,71 @@ func CmdGetPrices(storeKey string) *cobra.Command {
 		},
 	}
 }
+
+func CmdGetPriceHistory(storeKey string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "price-history [name] [from-epoch] [to-epoch]",
+		Short: "Query price snapshots stored in the epochs range",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := client.GetClientContextFromCmd(cmd)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/price_history/%s/%s/%s", storeKey, args[0], args[1], args[2]), nil)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.New("empty response")
+			}
+
+			var out types.QueryPriceHistoryResponse
+			cliCtx.JSONMarshaler.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintProto(&out)
+		},
+	}
+}
+
+func CmdGetTWAP(storeKey string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "twap [name] [window]",
+		Short: "Query time weighted average price over the last window epochs",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := client.GetClientContextFromCmd(cmd)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/twap/%s/%s", storeKey, args[0], args[1]), nil)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.New("empty response")
+			}
+
+			var out types.QueryTWAPResponse
+			cliCtx.JSONMarshaler.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintProto(&out)
+		},
+	}
+}
+
+func CmdGetVotingInfos(storeKey string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "voting-infos",
+		Short: "Query missed and conflicting price votes of the validators",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := client.GetClientContextFromCmd(cmd)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/voting_infos", storeKey), nil)
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return errors.New("empty response")
+			}
+
+			var out types.QueryVotingInfosResponse
+			cliCtx.JSONMarshaler.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintProto(&out)
+		},
+	}
+}
@@ -1 +1,71 @@
 package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/client/utils"
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+)
+
+func NewSubmitClearPriceHaltProposalTxCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "clear-price-halt [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to resume halted prices",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to resume halted prices along with an initial deposit.
+The last medians rejected by the circuit breaker are applied to the prices.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal clear-price-halt <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Resume ETH price",
+  "description": "ETH price moved because of the market crash",
+  "names": ["eth/0"],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := utils.ParseClearPriceHaltProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewClearPriceHaltProposal(proposal.Title, proposal.Description, proposal.Names)
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+}
@@ -0,0 +1,10 @@
+package client
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/oracle/client/cli"
+	"github.com/MinterTeam/mhub/chain/x/oracle/client/rest"
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+)
+
+// ProposalHandler is the clear price halt proposal handler.
+var ProposalHandler = govclient.NewProposalHandler(cli.NewSubmitClearPriceHaltProposalTxCmd, rest.ProposalRESTHandler)
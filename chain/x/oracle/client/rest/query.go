@@ -60,3 +60,57 @@ func ethFeeHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
 		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
 	}
 }
+
+// priceHistoryHandler expects name, from_epoch and to_epoch query params
+func priceHistoryHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/price_history/%s/%s/%s", storeName, query.Get("name"), query.Get("from_epoch"), query.Get("to_epoch")))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "price history not found")
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+	}
+}
+
+// twapHandler expects name and window query params
+func twapHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/twap/%s/%s", storeName, query.Get("name"), query.Get("window")))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "price not found")
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+	}
+}
+
+func votingInfosHandler(cliCtx client.Context, storeName string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, height, err := cliCtx.Query(fmt.Sprintf("custom/%s/voting_infos", storeName))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if len(res) == 0 {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "voting infos not found")
+			return
+		}
+
+		rest.PostProcessResponse(w, cliCtx.WithHeight(height), res)
+	}
+}
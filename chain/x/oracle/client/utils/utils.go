@@ -0,0 +1,48 @@
+package utils
+
+import (
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+)
+
+type (
+	// ClearPriceHaltProposalJSON defines a ClearPriceHaltProposal with a deposit used
+	// to parse clear price halt proposals from a JSON file.
+	ClearPriceHaltProposalJSON struct {
+		Title       string   `json:"title" yaml:"title"`
+		Description string   `json:"description" yaml:"description"`
+		Names       []string `json:"names" yaml:"names"`
+		Deposit     string   `json:"deposit" yaml:"deposit"`
+	}
+
+	// ClearPriceHaltProposalReq defines a clear price halt proposal request body.
+	ClearPriceHaltProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Names       []string       `json:"names" yaml:"names"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// ParseClearPriceHaltProposalJSON reads and parses a ClearPriceHaltProposalJSON from
+// file.
+func ParseClearPriceHaltProposalJSON(cdc *codec.LegacyAmino, proposalFile string) (ClearPriceHaltProposalJSON, error) {
+	proposal := ClearPriceHaltProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/MinterTeam/mhub/chain/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // NewHandler returns a handler for "Peggy" type messages.
@@ -24,3 +25,15 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 		}
 	}
 }
+
+func NewClearPriceHaltProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.ClearPriceHaltProposal:
+			return k.ClearPriceHalts(ctx, c.Names)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized proposal content type: %T", c)
+		}
+	}
+}
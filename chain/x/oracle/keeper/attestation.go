@@ -128,6 +128,7 @@ func (k Keeper) processAttestation(ctx sdk.Context, att *types.Attestation, clai
 		)
 	} else {
 		commit() // persist transient storage
+		ctx.EventManager().EmitEvents(xCtx.EventManager().Events())
 
 		// TODO: after we commit, delete the outgoingtxbatch that this claim references
 	}
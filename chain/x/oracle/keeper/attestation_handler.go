@@ -20,9 +20,15 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 	case *types.MsgPriceClaim:
 		votes := att.GetVotes()
 		pricesSum := map[string][]sdk.Int{}
+		votedPower := map[string]uint64{}
 
 		powers := a.keeper.GetNormalizedValPowers(ctx)
 
+		var totalPower uint64
+		for _, power := range powers {
+			totalPower += power
+		}
+
 		for _, valaddr := range votes {
 			validator, _ := sdk.ValAddressFromBech32(valaddr)
 			power := powers[valaddr]
@@ -30,20 +36,26 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 			priceClaim := a.keeper.GetClaim(ctx, sdk.AccAddress(validator).String(), claim.Epoch).(*types.GenericClaim).GetPriceClaim()
 			prices := priceClaim.GetPrices()
 			for _, item := range prices.List {
+				votedPower[item.Name] += power
 				for i := uint64(0); i < power; i++ {
 					pricesSum[item.Name] = append(pricesSum[item.Name], item.Value)
 				}
 			}
 		}
 
-		var priceNames []string
-		for name := range pricesSum {
-			priceNames = append(priceNames, name)
-		}
+		// only the prices the oracles are currently required to vote for are aggregated
+		priceNames := a.keeper.requiredPriceNames(ctx)
 		sort.Strings(priceNames)
 
+		quorum := a.keeper.GetParams(ctx).PriceQuorum
+
 		prices := types.Prices{}
 		for _, name := range priceNames {
+			if totalPower == 0 || sdk.NewDec(int64(votedPower[name])).LT(quorum.MulInt64(int64(totalPower))) {
+				a.keeper.flagMissingQuorum(ctx, name, claim.Epoch, votedPower[name], totalPower)
+				continue
+			}
+
 			price := pricesSum[name]
 
 			sort.Slice(price, func(i, j int) bool {
@@ -57,13 +69,17 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 				calculatedPrice = price[len(price)/2]
 			}
 
+			if !a.keeper.checkPriceChange(ctx, name, calculatedPrice, claim.Epoch) {
+				continue
+			}
+
 			prices.List = append(prices.List, &types.Price{
 				Name:  name,
 				Value: calculatedPrice,
 			})
 		}
 
-		a.keeper.storePrices(ctx, &prices)
+		a.keeper.storePrices(ctx, &prices, claim.Epoch)
 
 	default:
 		return sdkerrors.Wrapf(types.ErrInvalid, "event type: %s", claim.GetType())
@@ -0,0 +1,160 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GetPriceHalt returns the halt of the price, nil if the price is not halted
+func (k Keeper) GetPriceHalt(ctx sdk.Context, name string) *types.PriceHalt {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetPriceHaltKey(name))
+
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	var halt types.PriceHalt
+	k.cdc.MustUnmarshalBinaryBare(bytes, &halt)
+
+	return &halt
+}
+
+// GetPriceHalts returns the halts of all halted prices
+func (k Keeper) GetPriceHalts(ctx sdk.Context) []types.PriceHalt {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceHaltKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	halts := []types.PriceHalt{}
+	for ; iter.Valid(); iter.Next() {
+		var halt types.PriceHalt
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &halt)
+		halts = append(halts, halt)
+	}
+
+	return halts
+}
+
+func (k Keeper) setPriceHalt(ctx sdk.Context, halt *types.PriceHalt) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceHaltKey(halt.Name), k.cdc.MustMarshalBinaryBare(halt))
+}
+
+func (k Keeper) deletePriceHalt(ctx sdk.Context, name string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPriceHaltKey(name))
+}
+
+// GetMaxChangePerEpoch returns the max relative change of the price in an epoch, zero if the price change is not limited
+func (k Keeper) GetMaxChangePerEpoch(ctx sdk.Context, name string) sdk.Dec {
+	params := k.GetParams(ctx)
+
+	for _, coin := range params.Coins {
+		if fmt.Sprintf("minter/%d", coin.MinterId) == name && coin.MaxChangePerEpoch != nil {
+			return *coin.MaxChangePerEpoch
+		}
+	}
+
+	return params.MaxChangePerEpoch
+}
+
+// checkPriceChange tells whether the new median of the price may be stored. A price whose median moves more than
+// allowed in an epoch is halted. The halted price is resumed when the median comes back within the limit of the last
+// applied value, or when the rejected medians stay within the limit of each other for the recovery epochs.
+func (k Keeper) checkPriceChange(ctx sdk.Context, name string, value sdk.Int, epoch uint64) bool {
+	maxChange := k.GetMaxChangePerEpoch(ctx, name)
+	halt := k.GetPriceHalt(ctx, name)
+
+	if halt == nil {
+		lastValue, ok := k.getStoredPrice(ctx, name)
+		if !ok || maxChange.IsZero() || isWithinChange(lastValue, value, maxChange) {
+			return true
+		}
+
+		k.setPriceHalt(ctx, &types.PriceHalt{
+			Name:           name,
+			Epoch:          epoch,
+			LastValue:      lastValue,
+			CandidateValue: value,
+		})
+		k.emitPriceHaltEvent(ctx, types.EventTypePriceHalted, name, lastValue, value)
+
+		return false
+	}
+
+	if maxChange.IsZero() || isWithinChange(halt.LastValue, value, maxChange) {
+		k.resumePrice(ctx, halt)
+		return true
+	}
+
+	if isWithinChange(halt.CandidateValue, value, maxChange) {
+		halt.StableEpochs++
+	} else {
+		halt.StableEpochs = 0
+	}
+	halt.CandidateValue = value
+
+	if recoveryEpochs := k.GetParams(ctx).HaltRecoveryEpochs; recoveryEpochs != 0 && halt.StableEpochs >= recoveryEpochs {
+		k.resumePrice(ctx, halt)
+		return true
+	}
+
+	k.setPriceHalt(ctx, halt)
+
+	return false
+}
+
+// ClearPriceHalts applies the last rejected medians of the halted prices and resumes them, nothing is changed if any
+// of the prices is not halted
+func (k Keeper) ClearPriceHalts(ctx sdk.Context, names []string) error {
+	halts := make([]*types.PriceHalt, 0, len(names))
+	for _, name := range names {
+		halt := k.GetPriceHalt(ctx, name)
+		if halt == nil {
+			return sdkerrors.Wrapf(types.ErrUnknown, "price halt of %s", name)
+		}
+
+		halts = append(halts, halt)
+	}
+
+	prices := &types.Prices{}
+	for _, halt := range halts {
+		k.resumePrice(ctx, halt)
+		prices.List = append(prices.List, &types.Price{
+			Name:  halt.Name,
+			Value: halt.CandidateValue,
+		})
+	}
+
+	k.storePrices(ctx, prices, k.GetCurrentEpoch(ctx))
+
+	return nil
+}
+
+func (k Keeper) resumePrice(ctx sdk.Context, halt *types.PriceHalt) {
+	k.deletePriceHalt(ctx, halt.Name)
+	k.emitPriceHaltEvent(ctx, types.EventTypePriceResumed, halt.Name, halt.LastValue, halt.CandidateValue)
+}
+
+func (k Keeper) emitPriceHaltEvent(ctx sdk.Context, eventType string, name string, lastValue sdk.Int, rejectedValue sdk.Int) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		eventType,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyPriceName, name),
+		sdk.NewAttribute(types.AttributeKeyLastPrice, lastValue.String()),
+		sdk.NewAttribute(types.AttributeKeyRejectedPrice, rejectedValue.String()),
+	))
+}
+
+// isWithinChange tells whether the relative change from the previous value to the next one does not exceed maxChange
+func isWithinChange(previous sdk.Int, next sdk.Int, maxChange sdk.Dec) bool {
+	if !previous.IsPositive() {
+		return true
+	}
+
+	return next.Sub(previous).ToDec().Abs().LTE(maxChange.MulInt(previous))
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceCircuitBreaker(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	params := k.GetParams(ctx)
+	params.MaxChangePerEpoch = sdk.NewDecWithPrec(5, 1)
+	params.HaltRecoveryEpochs = 2
+	k.SetParams(ctx, params)
+
+	ctx = startEpoch(k, ctx)
+	ctx = observe(t, k, ctx, staking, claimPrices(1000)...)
+
+	// a median which moves more than allowed is not applied
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ctx = observe(t, k, ctx, staking, claimPrices(5000)...)
+	assertStoredPrice(t, k, ctx, types.PriceNameEth, 1000)
+	assert.Equal(t, []string{types.PriceNameEth}, eventValues(ctx, types.EventTypePriceHalted, types.AttributeKeyPriceName))
+
+	halt := k.GetPriceHalt(ctx, types.PriceNameEth)
+	require.NotNil(t, halt)
+	assert.Equal(t, sdk.NewInt(1000), halt.LastValue)
+	assert.Equal(t, sdk.NewInt(5000), halt.CandidateValue)
+	assert.Len(t, k.GetPriceHalts(ctx), 1)
+
+	_, err := k.getPrice(ctx, types.PriceNameEth)
+	assert.True(t, errors.Is(err, types.ErrPriceHalted))
+
+	// the price is resumed as soon as the median comes back within the limit
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ctx = observe(t, k, ctx, staking, claimPrices(1200)...)
+	assertStoredPrice(t, k, ctx, types.PriceNameEth, 1200)
+	assert.Nil(t, k.GetPriceHalt(ctx, types.PriceNameEth))
+	assert.Equal(t, []string{types.PriceNameEth}, eventValues(ctx, types.EventTypePriceResumed, types.AttributeKeyPriceName))
+
+	// or when the rejected medians are stable for the recovery epochs
+	ctx = observe(t, k, ctx, staking, claimPrices(5000)...)
+	ctx = observe(t, k, ctx, staking, claimPrices(5100)...)
+	assertStoredPrice(t, k, ctx, types.PriceNameEth, 1200)
+	assert.Equal(t, uint64(1), k.GetPriceHalt(ctx, types.PriceNameEth).StableEpochs)
+
+	ctx = observe(t, k, ctx, staking, claimPrices(5200)...)
+	assertStoredPrice(t, k, ctx, types.PriceNameEth, 5200)
+	assert.Empty(t, k.GetPriceHalts(ctx))
+
+	_, err = k.getPrice(ctx, types.PriceNameEth)
+	assert.NoError(t, err)
+}
+
+func TestClearPriceHalts(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+
+	ctx = startEpoch(k, ctx)
+	ctx = observe(t, k, ctx, staking, claimPrices(1000)...)
+	ctx = observe(t, k, ctx, staking, claimPrices(100)...)
+	require.NotNil(t, k.GetPriceHalt(ctx, types.PriceNameEth))
+
+	// nothing is cleared if any of the prices is not halted
+	assert.Error(t, k.ClearPriceHalts(ctx, []string{types.PriceNameEth, usdcPrice}))
+	assert.NotNil(t, k.GetPriceHalt(ctx, types.PriceNameEth))
+	assertStoredPrice(t, k, ctx, types.PriceNameEth, 1000)
+
+	require.NoError(t, k.ClearPriceHalts(ctx, []string{types.PriceNameEth}))
+	assertStoredPrice(t, k, ctx, types.PriceNameEth, 100)
+	assert.Nil(t, k.GetPriceHalt(ctx, types.PriceNameEth))
+	assert.Equal(t, k.GetCurrentEpoch(ctx), k.GetPriceUpdate(ctx, types.PriceNameEth).Epoch)
+}
+
+func TestMaxChangePerEpoch(t *testing.T) {
+	k, ctx, _ := CreateTestEnv(t)
+	params := k.GetParams(ctx)
+	params.MaxChangePerEpoch = sdk.NewDecWithPrec(5, 1)
+	coinLimit := sdk.NewDecWithPrec(1, 1)
+	params.Coins[0].MaxChangePerEpoch = &coinLimit
+	k.SetParams(ctx, params)
+
+	assert.Equal(t, coinLimit, k.GetMaxChangePerEpoch(ctx, usdcPrice))
+	assert.Equal(t, params.MaxChangePerEpoch, k.GetMaxChangePerEpoch(ctx, types.PriceNameEth))
+}
+
+func assertStoredPrice(t *testing.T, k Keeper, ctx sdk.Context, name string, exp int64) {
+	t.Helper()
+	value, ok := k.getStoredPrice(ctx, name)
+	require.True(t, ok)
+	assert.Equal(t, sdk.NewInt(exp), value)
+}
+
+// eventValues returns the values of the attribute of the emitted events of the type
+func eventValues(ctx sdk.Context, eventType string, key string) (values []string) {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == key {
+				values = append(values, string(attr.Value))
+			}
+		}
+	}
+
+	return values
+}
@@ -8,8 +8,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-const gweiInEth = 1e9
-
 var _ types.QueryServer = Keeper{}
 
 func (k Keeper) Coins(context context.Context, request *types.QueryCoinsRequest) (*types.QueryCoinsResponse, error) {
@@ -24,37 +22,108 @@ func (k Keeper) CurrentEpoch(context context.Context, request *types.QueryCurren
 	currentEpoch := types.Epoch{
 		Nonce: k.GetCurrentEpoch(ctx),
 	}
+	currentEpoch.Votes = k.getEpochVotes(ctx, currentEpoch.Nonce)
 
-	att := k.GetAttestation(ctx, currentEpoch.Nonce, &types.MsgPriceClaim{})
-	votes := att.GetVotes()
-	for _, valaddr := range votes {
-		validator, _ := sdk.ValAddressFromBech32(valaddr)
-		oracle := sdk.AccAddress(validator).String()
-		priceClaim := k.GetClaim(ctx, oracle, currentEpoch.Nonce).(*types.GenericClaim).GetPriceClaim()
-		currentEpoch.Votes = append(currentEpoch.Votes, &types.Vote{
-			Oracle: oracle,
-			Claim:  priceClaim,
-		})
-	}
-
-	return &types.QueryCurrentEpochResponse{Epoch: &currentEpoch}, nil
+	return &types.QueryCurrentEpochResponse{
+		Epoch:           &currentEpoch,
+		StartHeight:     k.GetEpochStartHeight(ctx),
+		EndHeight:       k.GetEpochEndHeight(ctx),
+		VotingEndHeight: k.GetVotingEndHeight(ctx),
+		Height:          ctx.BlockHeight(),
+	}, nil
 }
 
 func (k Keeper) EthFee(context context.Context, request *types.QueryEthFeeRequest) (*types.QueryEthFeeResponse, error) {
 	ctx := sdk.UnwrapSDKContext(context)
 
-	gasPrice, err := k.GetEthGasPrice(ctx)
+	minCost, err := k.EstimateWithdrawCostUSD(ctx, k.GetMinSingleWithdrawGas(ctx))
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "gas price")
+		return nil, err
 	}
 
-	ethPrice, err := k.GetEthPrice(ctx)
+	fastCost, err := k.EstimateWithdrawCostUSD(ctx, k.GetMinBatchGas(ctx))
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "eth price")
+		return nil, err
 	}
 
 	return &types.QueryEthFeeResponse{
-		Min:  gasPrice.Mul(ethPrice).MulRaw(int64(k.GetMinSingleWithdrawGas(ctx))).QuoRaw(gweiInEth).QuoRaw(k.GetGasUnits()),
-		Fast: gasPrice.Mul(ethPrice).MulRaw(int64(k.GetMinBatchGas(ctx))).QuoRaw(gweiInEth).QuoRaw(k.GetGasUnits()),
+		Min:  types.PriceFromDec(minCost, types.DefaultPricePrecision),
+		Fast: types.PriceFromDec(fastCost, types.DefaultPricePrecision),
+	}, nil
+}
+
+func (k Keeper) PriceHistory(context context.Context, request *types.QueryPriceHistoryRequest) (*types.QueryPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	return &types.QueryPriceHistoryResponse{
+		Snapshots: k.GetPriceHistory(ctx, request.Name, request.FromEpoch, request.ToEpoch),
+	}, nil
+}
+
+func (k Keeper) TWAP(context context.Context, request *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	price, err := k.GetTWAP(ctx, request.Name, request.Window)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTWAPResponse{Price: price}, nil
+}
+
+func (k Keeper) VotingInfos(context context.Context, request *types.QueryVotingInfosRequest) (*types.QueryVotingInfosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	return &types.QueryVotingInfosResponse{VotingInfos: k.GetVotingInfos(ctx)}, nil
+}
+
+func (k Keeper) Prices(context context.Context, request *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	return &types.QueryPricesResponse{Prices: k.GetPrices(ctx).GetList()}, nil
+}
+
+func (k Keeper) Price(context context.Context, request *types.QueryPriceRequest) (*types.QueryPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	value, found := k.getStoredPrice(ctx, request.Name)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrUnknown, "price: %s", request.Name)
+	}
+
+	return &types.QueryPriceResponse{
+		Price:  &types.Price{Name: request.Name, Value: value},
+		Update: k.GetPriceUpdate(ctx, request.Name),
+		Halt:   k.GetPriceHalt(ctx, request.Name),
 	}, nil
 }
+
+func (k Keeper) TxStatus(context context.Context, request *types.QueryTxStatusRequest) (*types.QueryTxStatusResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	return &types.QueryTxStatusResponse{Status: k.GetTxStatus(ctx, request.InTxHash)}, nil
+}
+
+func (k Keeper) EpochVotes(context context.Context, request *types.QueryEpochVotesRequest) (*types.QueryEpochVotesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(context)
+
+	return &types.QueryEpochVotesResponse{Votes: k.getEpochVotes(ctx, request.Epoch)}, nil
+}
+
+// getEpochVotes returns the price claims submitted by the oracles in the epoch
+func (k Keeper) getEpochVotes(ctx sdk.Context, epoch uint64) []*types.Vote {
+	var votes []*types.Vote
+
+	att := k.GetAttestation(ctx, epoch, &types.MsgPriceClaim{})
+	for _, valaddr := range att.GetVotes() {
+		validator, _ := sdk.ValAddressFromBech32(valaddr)
+		oracle := sdk.AccAddress(validator).String()
+		priceClaim := k.GetClaim(ctx, oracle, epoch).(*types.GenericClaim).GetPriceClaim()
+		votes = append(votes, &types.Vote{
+			Oracle: oracle,
+			Claim:  priceClaim,
+		})
+	}
+
+	return votes
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceQueries(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	ctx = startEpoch(k, ctx)
+	ctx = observe(t, k, ctx, staking, claimPrices(2000)...)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	prices, err := k.Prices(goCtx, &types.QueryPricesRequest{})
+	require.NoError(t, err)
+	// the deprecated gas price is published along the fees, it rounds down to 0 at the fees of the test prices
+	assert.ElementsMatch(t, append(claimPrices(2000), price(types.PriceNameEthGas, 0)), prices.Prices)
+
+	resp, err := k.Price(goCtx, &types.QueryPriceRequest{Name: usdcPrice})
+	require.NoError(t, err)
+	assert.Equal(t, price(usdcPrice, 1000), resp.Price)
+	require.NotNil(t, resp.Update)
+	assert.Equal(t, uint64(1), resp.Update.Epoch)
+	assert.Equal(t, int64(10), resp.Update.Height)
+	assert.Nil(t, resp.Halt)
+
+	_, err = k.Price(goCtx, &types.QueryPriceRequest{Name: "minter/1"})
+	assert.Error(t, err)
+}
+
+func TestLegacyGasPriceQuery(t *testing.T) {
+	const gwei = 1e10 // in the default price precision
+
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	ctx = startEpoch(k, ctx)
+	ctx = observe(t, k, ctx, staking, price(types.PriceNameEthBaseFee, 30*gwei), price(types.PriceNameEthPriorityFee, 1.5*gwei))
+
+	resp, err := k.Price(sdk.WrapSDKContext(ctx), &types.QueryPriceRequest{Name: types.PriceNameEthGas})
+	require.NoError(t, err)
+	assert.Equal(t, price(types.PriceNameEthGas, 315), resp.Price)
+
+	// the gas price follows the fees voted later
+	ctx = observe(t, k, ctx, staking, price(types.PriceNameEthPriorityFee, 2*gwei))
+	resp, err = k.Price(sdk.WrapSDKContext(ctx), &types.QueryPriceRequest{Name: types.PriceNameEthGas})
+	require.NoError(t, err)
+	assert.Equal(t, price(types.PriceNameEthGas, 320), resp.Price)
+}
+
+func TestTxStatusQuery(t *testing.T) {
+	k, ctx, _ := CreateTestEnv(t)
+	goCtx := sdk.WrapSDKContext(ctx)
+
+	resp, err := k.TxStatus(goCtx, &types.QueryTxStatusRequest{InTxHash: "0x01"})
+	require.NoError(t, err)
+	assert.Equal(t, types.TX_STATUS_NOT_FOUND, resp.Status.Status)
+
+	k.SetTxStatus(ctx, "0x01", types.TX_STATUS_BATCH_EXECUTED, "0x02")
+	resp, err = k.TxStatus(goCtx, &types.QueryTxStatusRequest{InTxHash: "0x01"})
+	require.NoError(t, err)
+	assert.Equal(t, &types.TxStatus{
+		InTxHash:  "0x01",
+		OutTxHash: "0x02",
+		Status:    types.TX_STATUS_BATCH_EXECUTED,
+	}, resp.Status)
+}
+
+func TestEpochVotesQuery(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	ctx = startEpoch(k, ctx)
+	epoch := k.GetCurrentEpoch(ctx)
+
+	require.NoError(t, vote(ctx, k, staking, 0, claimPrices(2000)...))
+	require.NoError(t, vote(ctx, k, staking, 2, claimPrices(2010)...))
+	ctx = startEpoch(k, ctx)
+
+	resp, err := k.EpochVotes(sdk.WrapSDKContext(ctx), &types.QueryEpochVotesRequest{Epoch: epoch})
+	require.NoError(t, err)
+	require.Len(t, resp.Votes, 2)
+
+	assert.Equal(t, sdk.AccAddress(valAddr(staking, 0)).String(), resp.Votes[0].Oracle)
+	assert.Equal(t, claimPrices(2000), resp.Votes[0].Claim.Prices.List)
+	assert.Equal(t, sdk.AccAddress(valAddr(staking, 2)).String(), resp.Votes[1].Oracle)
+	assert.Equal(t, claimPrices(2010), resp.Votes[1].Claim.Prices.List)
+
+	resp, err = k.EpochVotes(sdk.WrapSDKContext(ctx), &types.QueryEpochVotesRequest{Epoch: epoch + 1})
+	require.NoError(t, err)
+	assert.Empty(t, resp.Votes)
+}
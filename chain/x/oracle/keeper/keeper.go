@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"math/big"
+	"sort"
 
 	"github.com/MinterTeam/mhub/chain/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -102,36 +103,131 @@ func (k Keeper) GetTxStatus(ctx sdk.Context, inTxHash string) *types.TxStatus {
 	return &status
 }
 
-func (k Keeper) GetMinterPrice(ctx sdk.Context, id uint64) (sdk.Int, error) {
-	return k.getPrice(ctx, fmt.Sprintf("minter/%d", id))
+// GetMinterPrice returns the USD price of the Minter coin
+func (k Keeper) GetMinterPrice(ctx sdk.Context, id uint64) (sdk.Dec, error) {
+	precision := uint64(types.DefaultPricePrecision)
+	if coin, err := k.GetCoins(ctx).GetByMinterId(id); err == nil {
+		precision = coin.EffectivePricePrecision()
+	}
+
+	price, err := k.getPrice(ctx, fmt.Sprintf("minter/%d", id))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return types.PriceToDec(price, precision), nil
+}
+
+// GetEthBaseFee returns the Ethereum base fee per gas in gwei
+func (k Keeper) GetEthBaseFee(ctx sdk.Context) (sdk.Dec, error) {
+	return k.getDefaultPrecisionPrice(ctx, types.PriceNameEthBaseFee)
 }
 
-func (k Keeper) GetEthGasPrice(ctx sdk.Context) (sdk.Int, error) {
-	return k.getPrice(ctx, "eth/gas")
+// GetEthPriorityFee returns the Ethereum priority fee per gas in gwei
+func (k Keeper) GetEthPriorityFee(ctx sdk.Context) (sdk.Dec, error) {
+	return k.getDefaultPrecisionPrice(ctx, types.PriceNameEthPriorityFee)
+}
+
+// GetEthPrice returns the USD price of ether
+func (k Keeper) GetEthPrice(ctx sdk.Context) (sdk.Dec, error) {
+	return k.getDefaultPrecisionPrice(ctx, types.PriceNameEth)
+}
+
+// EstimateWithdrawCostUSD returns the USD cost of spending gasUnits of gas on Ethereum at the current base and
+// priority fees
+func (k Keeper) EstimateWithdrawCostUSD(ctx sdk.Context, gasUnits uint64) (sdk.Dec, error) {
+	baseFee, err := k.GetEthBaseFee(ctx)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(err, "base fee")
+	}
+
+	priorityFee, err := k.GetEthPriorityFee(ctx)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(err, "priority fee")
+	}
+
+	ethPrice, err := k.GetEthPrice(ctx)
+	if err != nil {
+		return sdk.Dec{}, sdkerrors.Wrap(err, "eth price")
+	}
+
+	return types.GasCostUSD(ethPrice, baseFee.Add(priorityFee), gasUnits), nil
 }
 
-func (k Keeper) GetEthPrice(ctx sdk.Context) (sdk.Int, error) {
-	return k.getPrice(ctx, "eth/0")
+func (k Keeper) getDefaultPrecisionPrice(ctx sdk.Context, key string) (sdk.Dec, error) {
+	price, err := k.getPrice(ctx, key)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return types.PriceToDec(price, types.DefaultPricePrecision), nil
 }
 
+// getPrice returns the price used in the bridge fee calculations, which is either the latest price or its time
+// weighted average
 func (k Keeper) getPrice(ctx sdk.Context, key string) (sdk.Int, error) {
-	store := ctx.KVStore(k.storeKey)
-	bytes := store.Get(types.CurrentPricesKey)
+	for _, price := range k.GetPrices(ctx).GetList() {
+		if price.GetName() != key {
+			continue
+		}
 
-	if len(bytes) == 0 {
-		return sdk.Int{}, sdkerrors.ErrKeyNotFound
+		if k.GetPriceHalt(ctx, key) != nil {
+			return sdk.Int{}, sdkerrors.Wrap(types.ErrPriceHalted, key)
+		}
+
+		if k.isPriceStale(ctx, key) {
+			return sdk.Int{}, sdkerrors.Wrap(types.ErrStalePrice, key)
+		}
+
+		if window := k.GetParams(ctx).FeeTwapWindow; window != 0 {
+			return k.GetTWAP(ctx, key, window)
+		}
+
+		return price.Value, nil
 	}
 
-	var prices types.Prices
-	k.cdc.MustUnmarshalBinaryBare(bytes, &prices)
+	return sdk.Int{}, sdkerrors.ErrKeyNotFound
+}
 
-	for _, price := range prices.GetList() {
+// getStoredPrice returns the last stored median of the price
+func (k Keeper) getStoredPrice(ctx sdk.Context, key string) (sdk.Int, bool) {
+	for _, price := range k.GetPrices(ctx).GetList() {
 		if price.GetName() == key {
-			return price.Value, nil
+			return price.Value, true
 		}
 	}
 
-	return sdk.Int{}, sdkerrors.ErrKeyNotFound
+	return sdk.Int{}, false
+}
+
+// isPriceStale tells whether the price was not updated for more than max price age blocks
+func (k Keeper) isPriceStale(ctx sdk.Context, key string) bool {
+	maxAge := k.GetParams(ctx).MaxPriceAge
+	if maxAge == 0 {
+		return false
+	}
+
+	update := k.GetPriceUpdate(ctx, key)
+	if update == nil {
+		return true
+	}
+
+	return ctx.BlockHeight()-update.Height > int64(maxAge)
+}
+
+// GetPriceUpdate returns when the price was last updated, nil if it is unknown
+func (k Keeper) GetPriceUpdate(ctx sdk.Context, key string) *types.PriceUpdate {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetPriceUpdateKey(key))
+
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	var update types.PriceUpdate
+	k.cdc.MustUnmarshalBinaryBare(bytes, &update)
+
+	return &update
 }
 
 func (k Keeper) GetCurrentEpoch(ctx sdk.Context) uint64 {
@@ -149,6 +245,37 @@ func (k Keeper) setCurrentEpoch(ctx sdk.Context, nonce uint64) {
 	store.Set(types.CurrentEpochKey, types.UInt64Bytes(nonce))
 }
 
+// GetEpochStartHeight returns the first block of the current epoch
+func (k Keeper) GetEpochStartHeight(ctx sdk.Context) int64 {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.EpochStartHeightKey)
+
+	if len(bytes) == 0 {
+		return 1
+	}
+	return int64(types.UInt64FromBytes(bytes))
+}
+
+func (k Keeper) setEpochStartHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochStartHeightKey, types.UInt64Bytes(uint64(height)))
+}
+
+// GetEpochEndHeight returns the last block of the current epoch, the epoch is processed at the end of it
+func (k Keeper) GetEpochEndHeight(ctx sdk.Context) int64 {
+	return k.GetEpochStartHeight(ctx) + int64(k.GetParams(ctx).EpochLength) - 1
+}
+
+// GetVotingEndHeight returns the last block in which the votes for the current epoch are accepted
+func (k Keeper) GetVotingEndHeight(ctx sdk.Context) int64 {
+	end := k.GetEpochStartHeight(ctx) + int64(k.GetParams(ctx).VotingWindow) - 1
+	if epochEnd := k.GetEpochEndHeight(ctx); end > epochEnd {
+		return epochEnd
+	}
+
+	return end
+}
+
 /////////////////////////////
 //       PARAMETERS        //
 /////////////////////////////
@@ -170,6 +297,16 @@ func (k Keeper) GetCoins(ctx sdk.Context) types.Coins {
 	return types.NewCoins(p.Coins)
 }
 
+// requiredPriceNames returns the names of the prices the oracles have to vote for in every epoch
+func (k Keeper) requiredPriceNames(ctx sdk.Context) []string {
+	names := []string{types.PriceNameEth, types.PriceNameEthBaseFee, types.PriceNameEthPriorityFee}
+	for _, coin := range k.GetCoins(ctx).List() {
+		names = append(names, fmt.Sprintf("minter/%d", coin.MinterId))
+	}
+
+	return names
+}
+
 func (k Keeper) GetMinSingleWithdrawGas(ctx sdk.Context) uint64 {
 	p := k.GetParams(ctx)
 
@@ -190,6 +327,7 @@ func (k Keeper) logger(ctx sdk.Context) log.Logger {
 func (k Keeper) ProcessCurrentEpoch(ctx sdk.Context) {
 	currentEpoch := k.GetCurrentEpoch(ctx)
 	k.setCurrentEpoch(ctx, currentEpoch+1)
+	k.setEpochStartHeight(ctx, ctx.BlockHeight()+1)
 
 	claim := &types.MsgPriceClaim{
 		Epoch: currentEpoch,
@@ -197,17 +335,120 @@ func (k Keeper) ProcessCurrentEpoch(ctx sdk.Context) {
 	att := k.GetAttestation(ctx, currentEpoch, claim)
 	if att != nil {
 		k.tryAttestation(ctx, att, claim)
+
+		// validators are held accountable only for the epochs in which the oracles agreed on the prices
+		if att.Observed {
+			k.trackVotes(ctx, currentEpoch, att)
+		}
 	}
 }
 
-func (k Keeper) storePrices(ctx sdk.Context, prices *types.Prices) {
+// storePrices updates the given prices voted in the epoch, the prices which were not voted are kept as is
+func (k Keeper) storePrices(ctx sdk.Context, prices *types.Prices, epoch uint64) {
+	current := k.GetPrices(ctx)
+	if current == nil {
+		current = &types.Prices{}
+	}
+
+	for _, price := range prices.GetList() {
+		found := false
+		for i, currentPrice := range current.List {
+			if currentPrice.Name == price.Name {
+				current.List[i] = price
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			current.List = append(current.List, price)
+		}
+
+		k.setPriceUpdate(ctx, &types.PriceUpdate{
+			Name:   price.Name,
+			Epoch:  epoch,
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+		})
+
+		k.addPriceSnapshot(ctx, price.Name, types.PriceSnapshot{
+			Epoch:  epoch,
+			Height: ctx.BlockHeight(),
+			Time:   ctx.BlockTime(),
+			Value:  price.Value,
+		})
+	}
+
+	setLegacyGasPrice(current)
+
+	sort.Slice(current.List, func(i, j int) bool {
+		return current.List[i].Name < current.List[j].Name
+	})
+
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.CurrentPricesKey, k.cdc.MustMarshalBinaryBare(prices))
+	store.Set(types.CurrentPricesKey, k.cdc.MustMarshalBinaryBare(current))
+}
+
+// setLegacyGasPrice publishes the deprecated eth/gas price as the sum of the current base and priority fees, so the
+// clients which read it keep working for one more release
+func setLegacyGasPrice(prices *types.Prices) {
+	var baseFee, priorityFee *types.Price
+	legacy := -1
+	for i, price := range prices.List {
+		switch price.Name {
+		case types.PriceNameEthBaseFee:
+			baseFee = price
+		case types.PriceNameEthPriorityFee:
+			priorityFee = price
+		case types.PriceNameEthGas:
+			legacy = i
+		}
+	}
+
+	if baseFee == nil || priorityFee == nil {
+		return
+	}
+
+	gasPrice := &types.Price{
+		Name: types.PriceNameEthGas,
+		Value: types.LegacyGasPrice(
+			types.PriceToDec(baseFee.Value, types.DefaultPricePrecision),
+			types.PriceToDec(priorityFee.Value, types.DefaultPricePrecision),
+		),
+	}
+
+	if legacy == -1 {
+		prices.List = append(prices.List, gasPrice)
+	} else {
+		prices.List[legacy] = gasPrice
+	}
 }
 
-func (k Keeper) GetGasUnits() int64 {
-	// TODO convert to gwei in oracle
-	return 10
+// flagMissingQuorum marks the price which did not reach the voting power quorum in the epoch, the previous value of the
+// price is kept
+func (k Keeper) flagMissingQuorum(ctx sdk.Context, name string, epoch uint64, votedPower uint64, totalPower uint64) {
+	update := k.GetPriceUpdate(ctx, name)
+	if update == nil {
+		update = &types.PriceUpdate{Name: name}
+	}
+
+	update.MissingQuorum = true
+	k.setPriceUpdate(ctx, update)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePriceMissingQuorum,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyPriceName, name),
+		sdk.NewAttribute(types.AttributeKeyEpoch, fmt.Sprint(epoch)),
+		sdk.NewAttribute(types.AttributeKeyVotedPower, fmt.Sprintf("%d/%d", votedPower, totalPower)),
+	))
+
+	k.logger(ctx).Info("price did not reach quorum", "name", name, "epoch", epoch, "voted_power", votedPower, "total_power", totalPower)
+}
+
+func (k Keeper) setPriceUpdate(ctx sdk.Context, update *types.PriceUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceUpdateKey(update.Name), k.cdc.MustMarshalBinaryBare(update))
 }
 
 func (k Keeper) GetNormalizedValPowers(ctx sdk.Context) map[string]uint64 {
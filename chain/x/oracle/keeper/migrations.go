@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateParams sets the default values of the params which were added after the chain has started, GetParams panics
+// on the params which are missing in the store
+func (k Keeper) MigrateParams(ctx sdk.Context) {
+	for _, pair := range types.DefaultParams().ParamSetPairs() {
+		if !k.paramSpace.Has(ctx, pair.Key) {
+			k.paramSpace.Set(ctx, pair.Key, pair.Value)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/MinterTeam/mhub/chain/x/oracle/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -30,26 +29,36 @@ func (k msgServer) PriceClaim(c context.Context, msg *types.MsgPriceClaim) (*typ
 		return nil, sdkerrors.Wrap(types.ErrUnknown, "validator")
 	}
 
-	if k.GetCurrentEpoch(ctx) != msg.GetEpoch() {
-		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "wrong epoch")
+	currentEpoch := k.GetCurrentEpoch(ctx)
+	if msg.GetEpoch() < currentEpoch {
+		return nil, sdkerrors.Wrapf(types.ErrVotingClosed, "epoch %d is over, current epoch is %d", msg.GetEpoch(), currentEpoch)
+	}
+	if msg.GetEpoch() > currentEpoch {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrLogic, "epoch %d is not started yet, current epoch is %d", msg.GetEpoch(), currentEpoch)
+	}
+	if votingEnd := k.GetVotingEndHeight(ctx); ctx.BlockHeight() > votingEnd {
+		return nil, sdkerrors.Wrapf(types.ErrVotingClosed, "voting for epoch %d ended at height %d", currentEpoch, votingEnd)
+	}
+
+	if len(msg.GetPrices().GetList()) == 0 {
+		return nil, sdkerrors.Wrap(types.ErrEmpty, "prices")
 	}
 
-	requiredPrices := []string{"eth/0", "eth/gas"}
-	for _, coin := range k.GetCoins(ctx).List() {
-		requiredPrices = append(requiredPrices, fmt.Sprintf("minter/%d", coin.MinterId))
+	knownPrices := map[string]bool{}
+	for _, name := range k.requiredPriceNames(ctx) {
+		knownPrices[name] = true
 	}
 
-	for _, requiredPrice := range requiredPrices {
-		found := false
-		for _, price := range msg.GetPrices().GetList() {
-			if price.GetName() == requiredPrice && price.Value.IsPositive() {
-				found = true
-				break
-			}
+	// an oracle whose price sources are down votes only for the prices it has, every price has to reach the quorum on
+	// its own anyway
+	for _, price := range msg.GetPrices().GetList() {
+		if !knownPrices[price.GetName()] {
+			return nil, sdkerrors.Wrapf(types.ErrUnknown, "price: %s", price.GetName())
 		}
 
-		if !found {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "required price not found or malformed: %s", requiredPrice)
+		// the priority fee may be zero when the blocks are not full
+		if !price.Value.IsPositive() && (price.GetName() != types.PriceNameEthPriorityFee || price.Value.IsNegative()) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "malformed price: %s", price.GetName())
 		}
 	}
 
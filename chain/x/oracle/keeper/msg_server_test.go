@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceClaimSubset(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	ctx = startEpoch(k, ctx)
+
+	specs := map[string]struct {
+		prices []*types.Price
+		expErr bool
+	}{
+		"subset of the prices":   {prices: []*types.Price{price(types.PriceNameEth, 2000)}},
+		"zero priority fee":      {prices: []*types.Price{price(types.PriceNameEthPriorityFee, 0)}},
+		"no prices":              {expErr: true},
+		"unknown price":          {prices: []*types.Price{price("minter/1", 1)}, expErr: true},
+		"zero price":             {prices: []*types.Price{price(types.PriceNameEth, 0)}, expErr: true},
+		"negative priority fee":  {prices: []*types.Price{price(types.PriceNameEthPriorityFee, -1)}, expErr: true},
+		"malformed among others": {prices: []*types.Price{price(types.PriceNameEth, 2000), price(usdcPrice, 0)}, expErr: true},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			err := vote(ctx, k, staking, 0, spec.prices...)
+			if spec.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPriceClaimVotingWindow(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	params := k.GetParams(ctx)
+	params.EpochLength = 10
+	params.VotingWindow = 4
+	k.SetParams(ctx, params)
+
+	ctx = startEpoch(k, ctx)
+	require.Equal(t, int64(11), ctx.BlockHeight())
+
+	resp, err := k.CurrentEpoch(sdk.WrapSDKContext(ctx), &types.QueryCurrentEpochRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), resp.Epoch.Nonce)
+	assert.Equal(t, int64(11), resp.StartHeight)
+	assert.Equal(t, int64(20), resp.EndHeight)
+	assert.Equal(t, int64(14), resp.VotingEndHeight)
+	assert.Equal(t, int64(11), resp.Height)
+
+	specs := map[string]struct {
+		height int64
+		epoch  uint64
+		expErr error
+	}{
+		"first block":        {height: 11, epoch: 1},
+		"last voting block":  {height: 14, epoch: 1},
+		"after voting ended": {height: 15, epoch: 1, expErr: types.ErrVotingClosed},
+		"past epoch":         {height: 12, epoch: 0, expErr: types.ErrVotingClosed},
+		"future epoch":       {height: 12, epoch: 2, expErr: sdkerrors.ErrLogic},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			ctx, _ := ctx.WithBlockHeight(spec.height).CacheContext()
+			_, err := NewMsgServerImpl(k).PriceClaim(sdk.WrapSDKContext(ctx), &types.MsgPriceClaim{
+				Epoch:        spec.epoch,
+				Prices:       &types.Prices{List: claimPrices(2000)},
+				Orchestrator: sdk.AccAddress(valAddr(staking, 0)).String(),
+			})
+			if spec.expErr != nil {
+				assert.True(t, errors.Is(err, spec.expErr), "unexpected error: %v", err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+
+	// the votes of the epoch are applied at its last block
+	ctx = observe(t, k, ctx, staking, claimPrices(2000)...)
+	assert.Equal(t, int64(21), ctx.BlockHeight())
+	assert.Equal(t, uint64(2), k.GetCurrentEpoch(ctx))
+	assert.Equal(t, int64(20), k.GetPriceUpdate(ctx, types.PriceNameEth).Height)
+
+	// the voting window does not outlast the epoch
+	params.VotingWindow = 100
+	k.SetParams(ctx, params)
+	assert.Equal(t, k.GetEpochEndHeight(ctx), k.GetVotingEndHeight(ctx))
+}
+
+func TestPriceMissingQuorum(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	ctx = startEpoch(k, ctx)
+	epoch := k.GetCurrentEpoch(ctx)
+
+	// all the oracles vote for ether, only one of them has the price of the coin
+	require.NoError(t, vote(ctx, k, staking, 0, price(types.PriceNameEth, 2000), price(usdcPrice, 1000)))
+	require.NoError(t, vote(ctx, k, staking, 1, price(types.PriceNameEth, 2000)))
+	require.NoError(t, vote(ctx, k, staking, 2, price(types.PriceNameEth, 2010)))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	startEpoch(k, ctx)
+
+	stored, ok := k.getStoredPrice(ctx, types.PriceNameEth)
+	require.True(t, ok)
+	assert.Equal(t, sdk.NewInt(2000), stored)
+	assert.False(t, k.GetPriceUpdate(ctx, types.PriceNameEth).MissingQuorum)
+
+	_, ok = k.getStoredPrice(ctx, usdcPrice)
+	assert.False(t, ok)
+	update := k.GetPriceUpdate(ctx, usdcPrice)
+	require.NotNil(t, update)
+	assert.True(t, update.MissingQuorum)
+
+	var flagged []string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != types.EventTypePriceMissingQuorum {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if string(attr.Key) == types.AttributeKeyPriceName {
+				flagged = append(flagged, string(attr.Value))
+			}
+		}
+	}
+	assert.ElementsMatch(t, []string{types.PriceNameEthBaseFee, types.PriceNameEthPriorityFee, usdcPrice}, flagged)
+
+	// the oracles which voted for a subset of the prices are not punished for the missing ones
+	assert.Empty(t, staking.Slashed)
+	assert.NotNil(t, k.GetVotingInfo(ctx, valAddr(staking, 1)))
+	assert.Zero(t, k.GetVotingInfo(ctx, valAddr(staking, 1)).MissedVotes)
+	assert.Equal(t, epoch+1, k.GetCurrentEpoch(ctx))
+}
+
+func TestPriceMissingQuorumGoesStale(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	params := k.GetParams(ctx)
+	params.MaxPriceAge = 12
+	k.SetParams(ctx, params)
+
+	ctx = startEpoch(k, ctx)
+	ctx = observe(t, k, ctx, staking, claimPrices(2000)...)
+	updated := k.GetPriceUpdate(ctx, usdcPrice).Height
+
+	// the oracles stop reporting the coin, its last price is kept until it is too old to be used
+	for ctx.BlockHeight()-updated <= int64(params.MaxPriceAge) {
+		value, err := k.getPrice(ctx, usdcPrice)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.NewInt(1000), value)
+
+		ctx = observe(t, k, ctx, staking, price(types.PriceNameEth, 2000))
+		assert.True(t, k.GetPriceUpdate(ctx, usdcPrice).MissingQuorum)
+		assert.Equal(t, updated, k.GetPriceUpdate(ctx, usdcPrice).Height)
+	}
+
+	stored, ok := k.getStoredPrice(ctx, usdcPrice)
+	require.True(t, ok)
+	assert.Equal(t, sdk.NewInt(1000), stored)
+
+	_, err := k.getPrice(ctx, usdcPrice)
+	assert.True(t, errors.Is(err, types.ErrStalePrice), "unexpected error: %v", err)
+
+	// ether has the quorum and stays fresh
+	_, err = k.getPrice(ctx, types.PriceNameEth)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// addPriceSnapshot stores the snapshot in the price history and drops the oldest snapshots beyond the history length
+func (k Keeper) addPriceSnapshot(ctx sdk.Context, name string, snapshot types.PriceSnapshot) {
+	length := k.GetParams(ctx).PriceHistoryLength
+	if length == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPriceHistoryKey(name, snapshot.Epoch), k.cdc.MustMarshalBinaryBare(&snapshot))
+
+	historyStore := prefix.NewStore(store, types.GetPriceHistoryPrefix(name))
+	iter := historyStore.ReverseIterator(nil, nil)
+
+	var outdated [][]byte
+	for count := uint64(0); iter.Valid(); iter.Next() {
+		count++
+		if count > length {
+			outdated = append(outdated, iter.Key())
+		}
+	}
+	iter.Close()
+
+	for _, key := range outdated {
+		historyStore.Delete(key)
+	}
+}
+
+// GetPriceHistory returns the snapshots of the price stored from fromEpoch to toEpoch inclusive, oldest first
+func (k Keeper) GetPriceHistory(ctx sdk.Context, name string, fromEpoch uint64, toEpoch uint64) []types.PriceSnapshot {
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetPriceHistoryPrefix(name))
+	iter := historyStore.Iterator(types.UInt64Bytes(fromEpoch), nil)
+	defer iter.Close()
+
+	snapshots := []types.PriceSnapshot{}
+	for ; iter.Valid(); iter.Next() {
+		if types.UInt64FromBytes(iter.Key()) > toEpoch {
+			break
+		}
+
+		var snapshot types.PriceSnapshot
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// GetTWAP returns the average of the price over the last window epochs, each snapshot is weighted by the number of
+// blocks it was in effect
+func (k Keeper) GetTWAP(ctx sdk.Context, name string, window uint64) (sdk.Int, error) {
+	currentEpoch := k.GetCurrentEpoch(ctx)
+
+	fromEpoch := uint64(0)
+	if currentEpoch > window {
+		fromEpoch = currentEpoch - window
+	}
+
+	snapshots := k.GetPriceHistory(ctx, name, fromEpoch, currentEpoch)
+	if len(snapshots) == 0 {
+		return sdk.Int{}, sdkerrors.Wrapf(sdkerrors.ErrKeyNotFound, "price history of %s", name)
+	}
+
+	sum := sdk.ZeroInt()
+	totalWeight := sdk.ZeroInt()
+	for i, snapshot := range snapshots {
+		until := ctx.BlockHeight()
+		if i+1 < len(snapshots) {
+			until = snapshots[i+1].Height
+		}
+
+		weight := until - snapshot.Height
+		if weight < 1 {
+			weight = 1
+		}
+
+		sum = sum.Add(snapshot.Value.MulRaw(weight))
+		totalWeight = totalWeight.AddRaw(weight)
+	}
+
+	return sum.Quo(totalWeight), nil
+}
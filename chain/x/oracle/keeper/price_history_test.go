@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriceHistory(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	params := k.GetParams(ctx)
+	params.PriceHistoryLength = 3
+	k.SetParams(ctx, params)
+
+	ctx = startEpoch(k, ctx)
+	for i := int64(0); i < 5; i++ {
+		ctx = observe(t, k, ctx, staking, claimPrices(1000+100*i)...)
+	}
+
+	// only the last snapshots are kept
+	history := k.GetPriceHistory(ctx, types.PriceNameEth, 0, 100)
+	require.Len(t, history, 3)
+	for i, snapshot := range history {
+		assert.Equal(t, uint64(3+i), snapshot.Epoch)
+		assert.Equal(t, sdk.NewInt(1200+100*int64(i)), snapshot.Value)
+		assert.Equal(t, int64(5*(3+i)+5), snapshot.Height)
+	}
+
+	resp, err := k.PriceHistory(sdk.WrapSDKContext(ctx), &types.QueryPriceHistoryRequest{
+		Name:      types.PriceNameEth,
+		FromEpoch: 4,
+		ToEpoch:   4,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Snapshots, 1)
+	assert.Equal(t, sdk.NewInt(1300), resp.Snapshots[0].Value)
+
+	assert.Empty(t, k.GetPriceHistory(ctx, "minter/1", 0, 100))
+}
+
+func TestTWAP(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+
+	// the prices are stored at the heights 10, 15 and 20
+	ctx = startEpoch(k, ctx)
+	for _, value := range []int64{1000, 1100, 1300} {
+		ctx = observe(t, k, ctx, staking, claimPrices(value)...)
+	}
+	ctx = ctx.WithBlockHeight(30)
+
+	specs := map[string]struct {
+		window uint64
+		exp    sdk.Int
+	}{
+		"all snapshots":   {window: 10, exp: sdk.NewInt((1000*5 + 1100*5 + 1300*10) / 20)},
+		"last two epochs": {window: 2, exp: sdk.NewInt((1100*5 + 1300*10) / 15)},
+		"last epoch":      {window: 1, exp: sdk.NewInt(1300)},
+	}
+
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			twap, err := k.GetTWAP(ctx, types.PriceNameEth, spec.window)
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, twap)
+
+			resp, err := k.TWAP(sdk.WrapSDKContext(ctx), &types.QueryTWAPRequest{Name: types.PriceNameEth, Window: spec.window})
+			require.NoError(t, err)
+			assert.Equal(t, spec.exp, resp.Price)
+		})
+	}
+
+	_, err := k.GetTWAP(ctx, "minter/1", 10)
+	assert.Error(t, err)
+
+	// the fees are calculated with the latest price unless the twap window is set
+	value, err := k.getPrice(ctx, types.PriceNameEth)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt(1300), value)
+
+	params := k.GetParams(ctx)
+	params.FeeTwapWindow = 10
+	k.SetParams(ctx, params)
+
+	value, err = k.getPrice(ctx, types.PriceNameEth)
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewInt((1000*5+1100*5+1300*10)/20), value)
+}
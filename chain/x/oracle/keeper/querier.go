@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"bytes"
+	"strings"
 
 	"github.com/MinterTeam/mhub/chain/x/oracle/types"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -17,6 +18,9 @@ const (
 	QueryEthFee       = "eth_fee"
 	QueryCoins        = "coins"
 	QueryTxStatus     = "tx_status"
+	QueryPriceHistory = "price_history"
+	QueryTWAP         = "twap"
+	QueryVotingInfos  = "voting_infos"
 )
 
 // NewQuerier is the module level router for state queries
@@ -33,6 +37,12 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryCoins(ctx, keeper)
 		case QueryTxStatus:
 			return queryTxStatus(ctx, keeper, path[1])
+		case QueryPriceHistory:
+			return queryPriceHistory(ctx, keeper, path[1:])
+		case QueryTWAP:
+			return queryTWAP(ctx, keeper, path[1:])
+		case QueryVotingInfos:
+			return queryVotingInfos(ctx, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint", types.ModuleName)
 		}
@@ -92,19 +102,19 @@ func queryTxStatus(ctx sdk.Context, keeper Keeper, txHash string) ([]byte, error
 }
 
 func queryEthFee(ctx sdk.Context, keeper Keeper) ([]byte, error) {
-	gasPrice, err := keeper.GetEthGasPrice(ctx)
+	minCost, err := keeper.EstimateWithdrawCostUSD(ctx, keeper.GetMinSingleWithdrawGas(ctx))
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "gas price")
+		return nil, err
 	}
 
-	ethPrice, err := keeper.GetEthPrice(ctx)
+	fastCost, err := keeper.EstimateWithdrawCostUSD(ctx, keeper.GetMinBatchGas(ctx))
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "eth price")
+		return nil, err
 	}
 
 	response := types.QueryEthFeeResponse{
-		Min:  gasPrice.Mul(ethPrice).MulRaw(int64(keeper.GetMinSingleWithdrawGas(ctx))).QuoRaw(gweiInEth).QuoRaw(keeper.GetGasUnits()).MulRaw(110).QuoRaw(100),
-		Fast: gasPrice.Mul(ethPrice).MulRaw(int64(keeper.GetMinBatchGas(ctx))).QuoRaw(gweiInEth).QuoRaw(keeper.GetGasUnits()).MulRaw(110).QuoRaw(100),
+		Min:  types.PriceFromDec(minCost.MulInt64(110).QuoInt64(100), types.DefaultPricePrecision),
+		Fast: types.PriceFromDec(fastCost.MulInt64(110).QuoInt64(100), types.DefaultPricePrecision),
 	}
 
 	res, err := codec.MarshalJSONIndent(types.ModuleCdc, &response)
@@ -114,3 +124,65 @@ func queryEthFee(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 
 	return res, nil
 }
+
+// queryPriceHistory handles the path [name..., from epoch, to epoch], the price name may contain slashes
+func queryPriceHistory(ctx sdk.Context, keeper Keeper, path []string) ([]byte, error) {
+	if len(path) < 3 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price name, from epoch and to epoch are required")
+	}
+
+	fromEpoch, err := types.UInt64FromString(path[len(path)-2])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "from epoch")
+	}
+
+	toEpoch, err := types.UInt64FromString(path[len(path)-1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "to epoch")
+	}
+
+	name := strings.Join(path[:len(path)-2], "/")
+	response := types.QueryPriceHistoryResponse{
+		Snapshots: keeper.GetPriceHistory(ctx, name, fromEpoch, toEpoch),
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, &response)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+// queryTWAP handles the path [name..., window], the price name may contain slashes
+func queryTWAP(ctx sdk.Context, keeper Keeper, path []string) ([]byte, error) {
+	if len(path) < 2 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "price name and window are required")
+	}
+
+	window, err := types.UInt64FromString(path[len(path)-1])
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "window")
+	}
+
+	price, err := keeper.GetTWAP(ctx, strings.Join(path[:len(path)-1], "/"), window)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, &types.QueryTWAPResponse{Price: price})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
+
+func queryVotingInfos(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, &types.QueryVotingInfosResponse{VotingInfos: keeper.GetVotingInfos(ctx)})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return res, nil
+}
@@ -1,3 +1,9 @@
+// The tests below came with the querier copied from the peggy module and cover the valset and batch queries which the
+// oracle does not have, they are kept out of the build until they are rewritten for the oracle queries.
+
+//go:build peggy
+// +build peggy
+
 package keeper
 
 import (
@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/std"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Ensure that StakingKeeperMock implements required interface
+var _ types.StakingKeeper = &StakingKeeperMock{}
+
+// CreateTestEnv creates the keeper testing environment for the oracle with a bonded validator for each of the given
+// powers, the oracle params are the default ones
+func CreateTestEnv(t *testing.T, powers ...int64) (Keeper, sdk.Context, *StakingKeeperMock) {
+	t.Helper()
+	keyOracle := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramstypes.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(paramstypes.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	const isCheckTx = false
+	ctx := sdk.NewContext(ms, tmproto.Header{
+		Height: 1,
+		Time:   time.Date(2020, time.April, 22, 12, 0, 0, 0, time.UTC),
+	}, isCheckTx, log.TestingLogger())
+
+	marshaler := MakeTestMarshaler()
+	paramsKeeper := paramskeeper.NewKeeper(marshaler, codec.NewLegacyAmino(), keyParams, tkeyParams)
+
+	stakingKeeper := NewStakingKeeperMock(t, powers...)
+	k := NewKeeper(marshaler, keyOracle, paramsKeeper.Subspace(types.ModuleName), stakingKeeper, nil)
+	k.SetParams(ctx, *types.DefaultParams())
+
+	return k, ctx, stakingKeeper
+}
+
+// MakeTestMarshaler creates a proto codec for use in testing
+func MakeTestMarshaler() codec.BinaryMarshaler {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	std.RegisterInterfaces(interfaceRegistry)
+	types.RegisterInterfaces(interfaceRegistry)
+	return codec.NewProtoCodec(interfaceRegistry)
+}
+
+// NewStakingKeeperMock creates a new mock staking keeper with a bonded validator for each of the given powers, the
+// operator address of the i-th validator consists of the bytes i+1
+func NewStakingKeeperMock(t *testing.T, powers ...int64) *StakingKeeperMock {
+	r := &StakingKeeperMock{
+		ValidatorPower: make(map[string]int64, len(powers)),
+		Slashed:        map[string]sdk.Dec{},
+		Jailed:         map[string]bool{},
+	}
+
+	for i, power := range powers {
+		operator := sdk.ValAddress(bytes.Repeat([]byte{byte(i + 1)}, sdk.AddrLen))
+		pubKey := ed25519.GenPrivKeyFromSecret(operator).PubKey()
+
+		validator, err := stakingtypes.NewValidator(operator, pubKey, stakingtypes.Description{})
+		require.NoError(t, err)
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(power)
+
+		r.BondedValidators = append(r.BondedValidators, validator)
+		r.ValidatorPower[operator.String()] = power
+	}
+
+	return r
+}
+
+// StakingKeeperMock is a mock staking keeper for use in the tests, it records the slashed and jailed validators by
+// their operator address
+type StakingKeeperMock struct {
+	BondedValidators []stakingtypes.Validator
+	ValidatorPower   map[string]int64
+	Slashed          map[string]sdk.Dec
+	Jailed           map[string]bool
+}
+
+// GetBondedValidatorsByPower implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator {
+	return s.BondedValidators
+}
+
+// GetLastValidatorPower implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64 {
+	return s.ValidatorPower[operator.String()]
+}
+
+// GetLastTotalPower implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) GetLastTotalPower(ctx sdk.Context) (power sdk.Int) {
+	var total int64
+	for _, v := range s.ValidatorPower {
+		total += v
+	}
+	return sdk.NewInt(total)
+}
+
+// IterateValidators implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) IterateValidators(ctx sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	s.IterateBondedValidatorsByPower(ctx, cb)
+}
+
+// IterateBondedValidatorsByPower implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) IterateBondedValidatorsByPower(ctx sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	for i, validator := range s.BondedValidators {
+		if cb(int64(i), validator) {
+			return
+		}
+	}
+}
+
+// IterateLastValidators implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) IterateLastValidators(ctx sdk.Context, cb func(index int64, validator stakingtypes.ValidatorI) (stop bool)) {
+	s.IterateBondedValidatorsByPower(ctx, cb)
+}
+
+// Validator implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) Validator(ctx sdk.Context, operator sdk.ValAddress) stakingtypes.ValidatorI {
+	for _, validator := range s.BondedValidators {
+		if validator.OperatorAddress == operator.String() {
+			return validator
+		}
+	}
+	return nil
+}
+
+// ValidatorByConsAddr implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) ValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) stakingtypes.ValidatorI {
+	if i := s.validatorIndex(consAddr); i >= 0 {
+		return s.BondedValidators[i]
+	}
+	return nil
+}
+
+// Slash implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight int64, power int64, fraction sdk.Dec) {
+	if i := s.validatorIndex(consAddr); i >= 0 {
+		s.Slashed[s.BondedValidators[i].OperatorAddress] = fraction
+	}
+}
+
+// Jail implements the interface for staking keeper required by oracle
+func (s *StakingKeeperMock) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	if i := s.validatorIndex(consAddr); i >= 0 {
+		s.BondedValidators[i].Jailed = true
+		s.Jailed[s.BondedValidators[i].OperatorAddress] = true
+	}
+}
+
+func (s *StakingKeeperMock) validatorIndex(consAddr sdk.ConsAddress) int {
+	for i, validator := range s.BondedValidators {
+		if addr, err := validator.GetConsAddr(); err == nil && addr.Equals(consAddr) {
+			return i
+		}
+	}
+	return -1
+}
+
+// usdcPrice is the price name of the coin of the default params
+const usdcPrice = "minter/1833"
+
+// startEpoch processes the current epoch at its last block and returns the context at the first block of the next one
+func startEpoch(k Keeper, ctx sdk.Context) sdk.Context {
+	ctx = ctx.WithBlockHeight(k.GetEpochEndHeight(ctx))
+	k.ProcessCurrentEpoch(ctx)
+
+	return ctx.WithBlockHeight(k.GetEpochStartHeight(ctx))
+}
+
+// observe submits the same prices from all the validators of the staking mock and processes the epoch, it returns the
+// context at the first block of the next epoch
+func observe(t *testing.T, k Keeper, ctx sdk.Context, staking *StakingKeeperMock, prices ...*types.Price) sdk.Context {
+	t.Helper()
+	for i := range staking.BondedValidators {
+		require.NoError(t, vote(ctx, k, staking, i, prices...))
+	}
+
+	return startEpoch(k, ctx)
+}
+
+// vote submits the price claim of the i-th validator of the staking mock for the current epoch
+func vote(ctx sdk.Context, k Keeper, staking *StakingKeeperMock, i int, prices ...*types.Price) error {
+	_, err := NewMsgServerImpl(k).PriceClaim(sdk.WrapSDKContext(ctx), &types.MsgPriceClaim{
+		Epoch:        k.GetCurrentEpoch(ctx),
+		Prices:       &types.Prices{List: prices},
+		Orchestrator: sdk.AccAddress(valAddr(staking, i)).String(),
+	})
+
+	return err
+}
+
+// claimPrices returns all the prices required by the default params with the given price of ether
+func claimPrices(eth int64) []*types.Price {
+	return []*types.Price{
+		price(types.PriceNameEth, eth),
+		price(types.PriceNameEthBaseFee, 100),
+		price(types.PriceNameEthPriorityFee, 2),
+		price(usdcPrice, 1000),
+	}
+}
+
+func valAddr(staking *StakingKeeperMock, i int) sdk.ValAddress {
+	return staking.BondedValidators[i].GetOperator()
+}
+
+func price(name string, value int64) *types.Price {
+	return &types.Price{Name: name, Value: sdk.NewInt(value)}
+}
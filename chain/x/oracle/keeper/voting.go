@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// GetVotingInfo returns the voting info of the validator, nil if its votes were not tracked yet
+func (k Keeper) GetVotingInfo(ctx sdk.Context, validator sdk.ValAddress) *types.VotingInfo {
+	store := ctx.KVStore(k.storeKey)
+	bytes := store.Get(types.GetVotingInfoKey(validator))
+
+	if len(bytes) == 0 {
+		return nil
+	}
+
+	var info types.VotingInfo
+	k.cdc.MustUnmarshalBinaryBare(bytes, &info)
+
+	return &info
+}
+
+// GetVotingInfos returns the voting infos of all tracked validators
+func (k Keeper) GetVotingInfos(ctx sdk.Context) []types.VotingInfo {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.VotingInfoKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	infos := []types.VotingInfo{}
+	for ; iter.Valid(); iter.Next() {
+		var info types.VotingInfo
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &info)
+		infos = append(infos, info)
+	}
+
+	return infos
+}
+
+func (k Keeper) setVotingInfo(ctx sdk.Context, validator sdk.ValAddress, info *types.VotingInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetVotingInfoKey(validator), k.cdc.MustMarshalBinaryBare(info))
+}
+
+// trackVotes counts the missed and conflicting votes of the bonded validators in the epoch. The validators which
+// exceed the allowed share of the signed claims window are slashed and jailed.
+func (k Keeper) trackVotes(ctx sdk.Context, epoch uint64, att *types.Attestation) {
+	params := k.GetParams(ctx)
+	window := params.SignedClaimsWindow
+	if window == 0 {
+		return
+	}
+
+	maxMissed := uint64(sdk.OneDec().Sub(params.MinSignedPerWindow).MulInt64(int64(window)).TruncateInt64())
+	maxConflicting := uint64(params.MaxConflictingPerWindow.MulInt64(int64(window)).TruncateInt64())
+
+	voted := map[string]bool{}
+	for _, validator := range att.GetVotes() {
+		voted[validator] = true
+	}
+
+	for _, validator := range k.StakingKeeper.GetBondedValidatorsByPower(ctx) {
+		valAddr := validator.GetOperator()
+
+		info := k.GetVotingInfo(ctx, valAddr)
+		if info == nil {
+			info = &types.VotingInfo{
+				Validator:        valAddr.String(),
+				WindowStartEpoch: epoch,
+			}
+		}
+
+		if !voted[valAddr.String()] {
+			info.MissedVotes++
+		} else if k.isConflictingVote(ctx, valAddr, epoch, params.PriceTolerance) {
+			info.ConflictingVotes++
+		}
+
+		switch {
+		case info.MissedVotes > maxMissed:
+			k.punishValidator(ctx, validator, params.SlashFractionClaim, "missed votes")
+		case info.ConflictingVotes > maxConflicting:
+			k.punishValidator(ctx, validator, params.SlashFractionConflictingClaim, "conflicting votes")
+		case epoch+1-info.WindowStartEpoch < window:
+			k.setVotingInfo(ctx, valAddr, info)
+			continue
+		}
+
+		// the window is over or the validator was punished, so the counting starts over
+		k.setVotingInfo(ctx, valAddr, &types.VotingInfo{
+			Validator:        valAddr.String(),
+			WindowStartEpoch: epoch + 1,
+		})
+	}
+}
+
+// isConflictingVote tells whether any price voted by the validator in the epoch deviates from the stored median by more
+// than the tolerance
+func (k Keeper) isConflictingVote(ctx sdk.Context, validator sdk.ValAddress, epoch uint64, tolerance sdk.Dec) bool {
+	claim := k.GetClaim(ctx, sdk.AccAddress(validator).String(), epoch)
+	if claim == nil {
+		return false
+	}
+
+	// the medians rejected by the circuit breaker are not stored, so the votes for halted prices are not checked
+	medians := map[string]sdk.Int{}
+	for _, price := range k.GetPrices(ctx).GetList() {
+		if update := k.GetPriceUpdate(ctx, price.Name); update != nil && update.Epoch == epoch {
+			medians[price.Name] = price.Value
+		}
+	}
+
+	for _, price := range claim.(*types.GenericClaim).GetPriceClaim().GetPrices().GetList() {
+		median, ok := medians[price.Name]
+		if !ok || !median.IsPositive() {
+			continue
+		}
+
+		if price.Value.Sub(median).ToDec().Abs().GT(tolerance.MulInt(median)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (k Keeper) punishValidator(ctx sdk.Context, validator stakingtypes.Validator, fraction sdk.Dec, reason string) {
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		k.logger(ctx).Error("could not punish oracle validator", "validator", validator.GetOperator().String(), "err", err.Error())
+		return
+	}
+
+	k.StakingKeeper.Slash(ctx, consAddr, ctx.BlockHeight(), validator.ConsensusPower(), fraction)
+	if !validator.IsJailed() {
+		k.StakingKeeper.Jail(ctx, consAddr)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeOracleSlash,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyValidator, validator.GetOperator().String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+	))
+}
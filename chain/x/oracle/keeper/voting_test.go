@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/oracle/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlashMissedVotes(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10, 10)
+	setVotingParams(k, ctx)
+	params := k.GetParams(ctx)
+
+	ctx = startEpoch(k, ctx)
+	missing := valAddr(staking, 3)
+
+	// a window of 4 epochs allows 2 missed votes
+	for epoch := uint64(1); epoch <= 2; epoch++ {
+		ctx = voteWith(t, k, ctx, staking, []int{0, 1, 2}, claimPrices(2000)...)
+
+		info := k.GetVotingInfo(ctx, missing)
+		require.NotNil(t, info)
+		assert.Equal(t, epoch, info.MissedVotes)
+		assert.Equal(t, uint64(1), info.WindowStartEpoch)
+		assert.Empty(t, staking.Slashed)
+	}
+
+	ctx = voteWith(t, k, ctx, staking, []int{0, 1, 2}, claimPrices(2000)...)
+	assert.Equal(t, map[string]sdk.Dec{missing.String(): params.SlashFractionClaim}, staking.Slashed)
+	assert.Equal(t, map[string]bool{missing.String(): true}, staking.Jailed)
+
+	// the counting starts over for the punished validator
+	info := k.GetVotingInfo(ctx, missing)
+	assert.Zero(t, info.MissedVotes)
+	assert.Equal(t, uint64(4), info.WindowStartEpoch)
+
+	// the window of the other validators is over after the fourth epoch
+	assert.Equal(t, uint64(1), k.GetVotingInfo(ctx, valAddr(staking, 0)).WindowStartEpoch)
+	ctx = voteWith(t, k, ctx, staking, []int{0, 1, 2}, claimPrices(2000)...)
+	assert.Equal(t, uint64(5), k.GetVotingInfo(ctx, valAddr(staking, 0)).WindowStartEpoch)
+
+	resp, err := k.VotingInfos(sdk.WrapSDKContext(ctx), &types.QueryVotingInfosRequest{})
+	require.NoError(t, err)
+	assert.Len(t, resp.VotingInfos, 4)
+}
+
+func TestSlashConflictingVotes(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	setVotingParams(k, ctx)
+	params := k.GetParams(ctx)
+
+	ctx = startEpoch(k, ctx)
+	conflicting := valAddr(staking, 2)
+
+	for epoch := uint64(1); epoch <= 3; epoch++ {
+		require.NoError(t, vote(ctx, k, staking, 0, claimPrices(2000)...))
+		require.NoError(t, vote(ctx, k, staking, 1, claimPrices(2100)...))
+		require.NoError(t, vote(ctx, k, staking, 2, claimPrices(3000)...))
+		ctx = startEpoch(k, ctx)
+
+		if epoch < 3 {
+			assert.Equal(t, epoch, k.GetVotingInfo(ctx, conflicting).ConflictingVotes)
+			assert.Zero(t, k.GetVotingInfo(ctx, valAddr(staking, 0)).ConflictingVotes)
+			assert.Zero(t, k.GetVotingInfo(ctx, valAddr(staking, 1)).ConflictingVotes)
+		}
+	}
+
+	assert.Equal(t, map[string]sdk.Dec{conflicting.String(): params.SlashFractionConflictingClaim}, staking.Slashed)
+	assert.Equal(t, map[string]bool{conflicting.String(): true}, staking.Jailed)
+	assert.Zero(t, k.GetVotingInfo(ctx, conflicting).ConflictingVotes)
+}
+
+func TestVotesNotTrackedWithoutConsensus(t *testing.T) {
+	k, ctx, staking := CreateTestEnv(t, 10, 10, 10)
+	setVotingParams(k, ctx)
+
+	ctx = startEpoch(k, ctx)
+	for epoch := 0; epoch < 5; epoch++ {
+		ctx = voteWith(t, k, ctx, staking, []int{0}, claimPrices(2000)...)
+	}
+
+	assert.Empty(t, k.GetVotingInfos(ctx))
+	assert.Empty(t, staking.Slashed)
+	assert.Empty(t, staking.Jailed)
+}
+
+// setVotingParams sets a window of 4 epochs in which a validator may miss 2 votes and vote for 2 conflicting prices
+func setVotingParams(k Keeper, ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	params.SignedClaimsWindow = 4
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.MaxConflictingPerWindow = sdk.NewDecWithPrec(5, 1)
+	params.PriceTolerance = sdk.NewDecWithPrec(1, 1)
+	params.SlashFractionClaim = sdk.NewDecWithPrec(1, 2)
+	params.SlashFractionConflictingClaim = sdk.NewDecWithPrec(2, 2)
+	k.SetParams(ctx, params)
+}
+
+// voteWith submits the same prices from the given validators of the staking mock and processes the epoch
+func voteWith(t *testing.T, k Keeper, ctx sdk.Context, staking *StakingKeeperMock, validators []int, prices ...*types.Price) sdk.Context {
+	t.Helper()
+	for _, i := range validators {
+		require.NoError(t, vote(ctx, k, staking, i, prices...))
+	}
+
+	return startEpoch(k, ctx)
+}
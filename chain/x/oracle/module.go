@@ -112,8 +112,7 @@ func (AppModule) Name() string {
 
 // RegisterInvariants implements app module
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
-	// TODO: make some invariants in the peggy module to ensure that
-	// coins aren't being fraudlently minted etc...
+	// the oracle does not hold any vouchers, their supply is checked by the peggy module
 }
 
 // Route implements app module
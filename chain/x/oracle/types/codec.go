@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // ModuleCdc is the codec for the module
@@ -25,6 +26,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*Claim)(nil),
 		&MsgPriceClaim{},
 	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&ClearPriceHaltProposal{},
+	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -34,4 +39,5 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*Claim)(nil), nil)
 	cdc.RegisterConcrete(&MsgPriceClaim{}, "oracle/MsgPriceClaim", nil)
 	cdc.RegisterConcrete(&Attestation{}, "oracle/Attestation", nil)
+	cdc.RegisterConcrete(&ClearPriceHaltProposal{}, "oracle/ClearPriceHaltProposal", nil)
 }
@@ -14,4 +14,7 @@ var (
 	ErrOutdated                = sdkerrors.Register(ModuleName, 7, "outdated")
 	ErrUnsupported             = sdkerrors.Register(ModuleName, 8, "unsupported")
 	ErrNonContiguousEventNonce = sdkerrors.Register(ModuleName, 9, "non contiguous event nonce")
+	ErrStalePrice              = sdkerrors.Register(ModuleName, 10, "stale price")
+	ErrPriceHalted             = sdkerrors.Register(ModuleName, 11, "price halted")
+	ErrVotingClosed            = sdkerrors.Register(ModuleName, 12, "voting closed")
 )
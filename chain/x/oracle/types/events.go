@@ -8,6 +8,10 @@ const (
 	EventTypeOutgoingBatchCanceled    = "outgoing_batch_canceled"
 	EventTypeBridgeWithdrawalReceived = "withdrawal_received"
 	EventTypeBridgeDepositReceived    = "deposit_received"
+	EventTypeOracleSlash              = "oracle_slash"
+	EventTypePriceHalted              = "price_halted"
+	EventTypePriceResumed             = "price_resumed"
+	EventTypePriceMissingQuorum       = "price_missing_quorum"
 
 	AttributeKeyAttestationID    = "attestation_id"
 	AttributeKeyAttestationIDs   = "attestation_ids"
@@ -23,4 +27,11 @@ const (
 	AttributeKeyBatchNonce       = "batch_nonce"
 	AttributeKeyBridgeChainID    = "bridge_chain_id"
 	AttributeKeySetOperatorAddr  = "set_operator_address"
+	AttributeKeyValidator        = "validator"
+	AttributeKeyReason           = "reason"
+	AttributeKeyPriceName        = "price_name"
+	AttributeKeyLastPrice        = "last_price"
+	AttributeKeyRejectedPrice    = "rejected_price"
+	AttributeKeyEpoch            = "epoch"
+	AttributeKeyVotedPower       = "voted_power"
 )
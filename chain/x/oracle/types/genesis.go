@@ -29,6 +29,23 @@ var (
 
 	ParamsCommission = []byte("Commission")
 
+	ParamsMaxPriceAge = []byte("MaxPriceAge")
+
+	ParamsPriceHistoryLength = []byte("PriceHistoryLength")
+	ParamsFeeTwapWindow      = []byte("FeeTwapWindow")
+
+	ParamsMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	ParamsPriceTolerance          = []byte("PriceTolerance")
+	ParamsMaxConflictingPerWindow = []byte("MaxConflictingPerWindow")
+
+	ParamsMaxChangePerEpoch  = []byte("MaxChangePerEpoch")
+	ParamsHaltRecoveryEpochs = []byte("HaltRecoveryEpochs")
+
+	ParamsPriceQuorum = []byte("PriceQuorum")
+
+	ParamsEpochLength  = []byte("EpochLength")
+	ParamsVotingWindow = []byte("VotingWindow")
+
 	// Ensure that params implements the proper interface
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -64,9 +81,20 @@ func DefaultParams() *Params {
 				EthDecimals: 6,
 			},
 		},
-		MinBatchGas:          100000,
-		MinSingleWithdrawGas: 50000,
-		Commission:           sdk.NewDec(1).Quo(sdk.NewDec(100)),
+		MinBatchGas:             100000,
+		MinSingleWithdrawGas:    50000,
+		Commission:              sdk.NewDec(1).Quo(sdk.NewDec(100)),
+		MaxPriceAge:             100,
+		PriceHistoryLength:      100,
+		FeeTwapWindow:           0,
+		MinSignedPerWindow:      sdk.NewDecWithPrec(5, 1),
+		PriceTolerance:          sdk.NewDecWithPrec(1, 1),
+		MaxConflictingPerWindow: sdk.NewDecWithPrec(5, 1),
+		MaxChangePerEpoch:       sdk.NewDecWithPrec(5, 1),
+		HaltRecoveryEpochs:      10,
+		PriceQuorum:             sdk.NewDecWithPrec(66, 2),
+		EpochLength:             5,
+		VotingWindow:            5,
 	}
 }
 
@@ -81,6 +109,18 @@ func (p Params) ValidateBasic() error {
 	if err := validateSlashFractionConflictingClaim(p.SlashFractionConflictingClaim); err != nil {
 		return sdkerrors.Wrap(err, "slash fraction valset")
 	}
+	if p.FeeTwapWindow != 0 && p.PriceHistoryLength == 0 {
+		return sdkerrors.Wrap(ErrInvalid, "fee twap window requires price history")
+	}
+	if err := validateEpochLength(p.EpochLength); err != nil {
+		return sdkerrors.Wrap(err, "epoch length")
+	}
+	if err := validateVotingWindow(p.VotingWindow); err != nil {
+		return sdkerrors.Wrap(err, "voting window")
+	}
+	if p.VotingWindow > p.EpochLength {
+		return sdkerrors.Wrap(ErrInvalid, "voting window exceeds epoch length")
+	}
 	return nil
 }
 
@@ -100,6 +140,17 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamsMinBatchGas, &p.MinBatchGas, validateMinBatchGas),
 		paramtypes.NewParamSetPair(ParamsMinSingleWithdrawGas, &p.MinSingleWithdrawGas, validateMinSingleWithdrawGas),
 		paramtypes.NewParamSetPair(ParamsCommission, &p.Commission, validateCommission),
+		paramtypes.NewParamSetPair(ParamsMaxPriceAge, &p.MaxPriceAge, validateMaxPriceAge),
+		paramtypes.NewParamSetPair(ParamsPriceHistoryLength, &p.PriceHistoryLength, validatePriceHistoryLength),
+		paramtypes.NewParamSetPair(ParamsFeeTwapWindow, &p.FeeTwapWindow, validateFeeTwapWindow),
+		paramtypes.NewParamSetPair(ParamsMinSignedPerWindow, &p.MinSignedPerWindow, validateShare),
+		paramtypes.NewParamSetPair(ParamsPriceTolerance, &p.PriceTolerance, validatePriceTolerance),
+		paramtypes.NewParamSetPair(ParamsMaxConflictingPerWindow, &p.MaxConflictingPerWindow, validateShare),
+		paramtypes.NewParamSetPair(ParamsMaxChangePerEpoch, &p.MaxChangePerEpoch, validateMaxChangePerEpoch),
+		paramtypes.NewParamSetPair(ParamsHaltRecoveryEpochs, &p.HaltRecoveryEpochs, validateHaltRecoveryEpochs),
+		paramtypes.NewParamSetPair(ParamsPriceQuorum, &p.PriceQuorum, validateShare),
+		paramtypes.NewParamSetPair(ParamsEpochLength, &p.EpochLength, validateEpochLength),
+		paramtypes.NewParamSetPair(ParamsVotingWindow, &p.VotingWindow, validateVotingWindow),
 	}
 }
 
@@ -153,6 +204,14 @@ func validateCoins(i interface{}) error {
 			return fmt.Errorf("incorrect eth addr")
 		}
 
+		if coin.PricePrecision > MaxPricePrecision {
+			return fmt.Errorf("incorrect price precision")
+		}
+
+		if coin.MaxChangePerEpoch != nil && coin.MaxChangePerEpoch.IsNegative() {
+			return fmt.Errorf("incorrect max change per epoch")
+		}
+
 		// todo: check duplicates
 	}
 
@@ -185,6 +244,103 @@ func validateMinBatchGas(i interface{}) error {
 	return nil
 }
 
+func validateMaxPriceAge(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validatePriceHistoryLength(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeTwapWindow(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateShare(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("share must be between 0 and 1: %s", v)
+	}
+
+	return nil
+}
+
+func validatePriceTolerance(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("price tolerance must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateMaxChangePerEpoch(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max change per epoch must not be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateHaltRecoveryEpochs(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateEpochLength(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("epoch length must be positive")
+	}
+
+	return nil
+}
+
+func validateVotingWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("voting window must be positive")
+	}
+
+	return nil
+}
+
 func strToFixByteArray(s string) ([32]byte, error) {
 	var out [32]byte
 	if len([]byte(s)) > 32 {
@@ -32,6 +32,28 @@ type Params struct {
 	MinSingleWithdrawGas          uint64                                 `protobuf:"varint,5,opt,name=min_single_withdraw_gas,json=minSingleWithdrawGas,proto3" json:"min_single_withdraw_gas,omitempty"`
 	MinBatchGas                   uint64                                 `protobuf:"varint,6,opt,name=min_batch_gas,json=minBatchGas,proto3" json:"min_batch_gas,omitempty"`
 	Commission                    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=commission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"commission"`
+	// max number of blocks since the last update of a price after which the price is stale, 0 disables the check
+	MaxPriceAge uint64 `protobuf:"varint,8,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// number of price snapshots kept in the history of each price
+	PriceHistoryLength uint64 `protobuf:"varint,9,opt,name=price_history_length,json=priceHistoryLength,proto3" json:"price_history_length,omitempty"`
+	// number of epochs the prices used in the bridge fee calculations are averaged over, 0 uses the latest prices
+	FeeTwapWindow uint64 `protobuf:"varint,10,opt,name=fee_twap_window,json=feeTwapWindow,proto3" json:"fee_twap_window,omitempty"`
+	// min share of the epochs in the signed claims window a validator has to vote in
+	MinSignedPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_signed_per_window"`
+	// max relative deviation of a voted price from the median, the votes beyond it are conflicting
+	PriceTolerance github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=price_tolerance,json=priceTolerance,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_tolerance"`
+	// max share of the epochs in the signed claims window a validator may vote conflicting prices in
+	MaxConflictingPerWindow github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=max_conflicting_per_window,json=maxConflictingPerWindow,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_conflicting_per_window"`
+	// default max relative change of a price in an epoch, 0 disables the circuit breaker
+	MaxChangePerEpoch github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,14,opt,name=max_change_per_epoch,json=maxChangePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_per_epoch"`
+	// number of consecutive stable epochs after which a halted price is resumed, 0 leaves it to governance
+	HaltRecoveryEpochs uint64 `protobuf:"varint,15,opt,name=halt_recovery_epochs,json=haltRecoveryEpochs,proto3" json:"halt_recovery_epochs,omitempty"`
+	// share of the voting power which has to vote for a price to update it
+	PriceQuorum github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=price_quorum,json=priceQuorum,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_quorum"`
+	// number of blocks in an epoch
+	EpochLength uint64 `protobuf:"varint,17,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// number of blocks from the start of an epoch in which the oracles may vote
+	VotingWindow uint64 `protobuf:"varint,18,opt,name=voting_window,json=votingWindow,proto3" json:"voting_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -95,6 +117,48 @@ func (m *Params) GetMinBatchGas() uint64 {
 	return 0
 }
 
+func (m *Params) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetPriceHistoryLength() uint64 {
+	if m != nil {
+		return m.PriceHistoryLength
+	}
+	return 0
+}
+
+func (m *Params) GetFeeTwapWindow() uint64 {
+	if m != nil {
+		return m.FeeTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetHaltRecoveryEpochs() uint64 {
+	if m != nil {
+		return m.HaltRecoveryEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Params) GetVotingWindow() uint64 {
+	if m != nil {
+		return m.VotingWindow
+	}
+	return 0
+}
+
 // GenesisState struct
 type GenesisState struct {
 	Params       *Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
@@ -156,37 +220,51 @@ func init() {
 func init() { proto.RegisterFile("oracle/v1/genesis.proto", fileDescriptor_14b982a0a6345d1d) }
 
 var fileDescriptor_14b982a0a6345d1d = []byte{
-	// 468 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x41, 0x6b, 0xd4, 0x40,
-	0x14, 0xc7, 0x37, 0xdd, 0xed, 0x8a, 0xb3, 0x2b, 0xe2, 0xb0, 0xda, 0x50, 0x31, 0x0d, 0x0b, 0xca,
-	0x7a, 0x30, 0xb1, 0x15, 0x2f, 0x9e, 0x74, 0x2b, 0x16, 0x0f, 0x8a, 0xa4, 0x42, 0xc1, 0x4b, 0x9c,
-	0x9d, 0x9d, 0x26, 0x0f, 0x33, 0x33, 0x4b, 0xde, 0xb4, 0x51, 0xbc, 0xf8, 0x11, 0xfc, 0x58, 0x3d,
-	0xf6, 0x28, 0x22, 0x45, 0x76, 0xcf, 0x7e, 0x07, 0xc9, 0x64, 0x70, 0x53, 0x8f, 0x3d, 0x25, 0xcc,
-	0xef, 0xbd, 0xff, 0x7f, 0xde, 0x9b, 0x3f, 0xd9, 0xd2, 0x25, 0xe3, 0x85, 0x88, 0x4f, 0x77, 0xe3,
-	0x4c, 0x28, 0x81, 0x80, 0xd1, 0xa2, 0xd4, 0x46, 0xd3, 0xeb, 0x0d, 0x88, 0x4e, 0x77, 0xb7, 0x47,
-	0x99, 0xce, 0xb4, 0x3d, 0x8d, 0xeb, 0xbf, 0xa6, 0x60, 0xfb, 0xee, 0xba, 0x93, 0x19, 0x23, 0xd0,
-	0x30, 0x03, 0x5a, 0x39, 0x78, 0x7b, 0x0d, 0xcd, 0x97, 0x85, 0x70, 0xa2, 0xe3, 0x3f, 0x5d, 0xd2,
-	0x7f, 0xc7, 0x4a, 0x26, 0x91, 0x3e, 0x26, 0x23, 0x84, 0x4c, 0x89, 0x79, 0xca, 0x0b, 0x06, 0x12,
-	0xd3, 0x0a, 0xd4, 0x5c, 0x57, 0xbe, 0x17, 0x7a, 0x93, 0x5e, 0x42, 0x1b, 0xb6, 0x6f, 0xd1, 0x91,
-	0x25, 0xf4, 0x23, 0x19, 0x61, 0xc1, 0x30, 0x4f, 0x8f, 0x4b, 0xc6, 0x6b, 0xaf, 0xa6, 0xd3, 0xdf,
-	0x08, 0xbd, 0xc9, 0x70, 0x1a, 0x9d, 0x5d, 0xec, 0x74, 0x7e, 0x5e, 0xec, 0x3c, 0xc8, 0xc0, 0xe4,
-	0x27, 0xb3, 0x88, 0x6b, 0x19, 0x73, 0x8d, 0x52, 0xa3, 0xfb, 0x3c, 0xc2, 0xf9, 0x27, 0x77, 0x99,
-	0x97, 0x82, 0x27, 0xd4, 0x6a, 0xbd, 0x72, 0x52, 0xd6, 0x88, 0x56, 0x24, 0xfc, 0xdf, 0x41, 0xab,
-	0xe3, 0x02, 0xb8, 0x01, 0x95, 0x39, 0xb7, 0xee, 0x95, 0xdc, 0xee, 0x5d, 0x76, 0x5b, 0xab, 0x36,
-	0xc6, 0xf7, 0xc9, 0x26, 0xd7, 0xa0, 0xd0, 0xef, 0x85, 0xdd, 0xc9, 0x60, 0xef, 0x66, 0xf4, 0x6f,
-	0xf9, 0xd1, 0xbe, 0x06, 0x95, 0x34, 0x94, 0x3e, 0x25, 0x5b, 0x12, 0x54, 0x8a, 0xa0, 0xb2, 0x42,
-	0xa4, 0x15, 0x98, 0x7c, 0x5e, 0xb2, 0x2a, 0xcd, 0x18, 0xfa, 0x9b, 0x76, 0x6d, 0x23, 0x09, 0xea,
-	0xd0, 0xd2, 0x23, 0x07, 0x0f, 0x18, 0xd2, 0x31, 0xb9, 0x51, 0xb7, 0xcd, 0x98, 0xe1, 0xb9, 0x2d,
-	0xee, 0xdb, 0xe2, 0x81, 0x04, 0x35, 0xad, 0xcf, 0xea, 0x9a, 0xb7, 0x84, 0x70, 0x2d, 0x25, 0x20,
-	0x82, 0x56, 0xfe, 0xb5, 0x2b, 0x0d, 0xd9, 0x52, 0x78, 0xd6, 0xfb, 0xf6, 0x2b, 0xec, 0x8c, 0xbf,
-	0x92, 0xe1, 0x41, 0x93, 0xaa, 0x43, 0xc3, 0x8c, 0xa0, 0x0f, 0x49, 0x7f, 0x61, 0x9f, 0xdf, 0x3e,
-	0xf3, 0x60, 0xef, 0x56, 0x6b, 0xd0, 0x26, 0x17, 0x89, 0x2b, 0xa0, 0xcf, 0xc9, 0xb0, 0x15, 0x2b,
-	0xf4, 0x37, 0xec, 0x66, 0xee, 0xb4, 0x1a, 0x5e, 0xac, 0xf1, 0xb4, 0x57, 0x5f, 0x35, 0xb9, 0xd4,
-	0x31, 0x7d, 0x7d, 0xb6, 0x0c, 0xbc, 0xf3, 0x65, 0xe0, 0xfd, 0x5e, 0x06, 0xde, 0xf7, 0x55, 0xd0,
-	0x39, 0x5f, 0x05, 0x9d, 0x1f, 0xab, 0xa0, 0xf3, 0x21, 0x6e, 0x0d, 0xf4, 0x06, 0x94, 0x11, 0xe5,
-	0x7b, 0xc1, 0x64, 0x2c, 0xf3, 0x93, 0x59, 0xcc, 0x73, 0x06, 0x2a, 0xfe, 0x1c, 0xbb, 0x00, 0xdb,
-	0xe9, 0x66, 0x7d, 0x1b, 0xdf, 0x27, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0x0a, 0x76, 0x1f,
-	0x2e, 0x03, 0x00, 0x00,
+	// 700 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x1b, 0x39,
+	0x14, 0x4e, 0x20, 0x64, 0x17, 0x27, 0x21, 0x8b, 0x15, 0x96, 0x11, 0xab, 0x0d, 0x59, 0x56, 0x8b,
+	0xd8, 0xc3, 0x66, 0x16, 0xaa, 0x5e, 0x7a, 0x2a, 0xa1, 0x2d, 0xad, 0xd4, 0x56, 0x21, 0x20, 0x21,
+	0xf5, 0x32, 0x75, 0x1c, 0x67, 0xc6, 0x62, 0xc6, 0x9e, 0xda, 0xce, 0x0f, 0xd4, 0x4b, 0xff, 0x84,
+	0xfe, 0x4d, 0x3d, 0x71, 0xe4, 0x58, 0x55, 0x15, 0xaa, 0xe0, 0x1f, 0xa9, 0xe6, 0xd9, 0x69, 0x86,
+	0x1e, 0x73, 0x4a, 0xf4, 0xbe, 0xf7, 0xbe, 0xcf, 0xfe, 0xe6, 0xf3, 0x43, 0x9b, 0x52, 0x11, 0x1a,
+	0x33, 0x7f, 0xbc, 0xef, 0x87, 0x4c, 0x30, 0xcd, 0x75, 0x3b, 0x55, 0xd2, 0x48, 0xbc, 0x6a, 0x81,
+	0xf6, 0x78, 0x7f, 0xab, 0x11, 0xca, 0x50, 0x42, 0xd5, 0xcf, 0xfe, 0xd9, 0x86, 0xad, 0x3f, 0xe6,
+	0x93, 0xc4, 0x18, 0xa6, 0x0d, 0x31, 0x5c, 0x0a, 0x07, 0x6e, 0xcc, 0x41, 0x73, 0x99, 0x32, 0x47,
+	0xba, 0xf3, 0x69, 0x15, 0x95, 0xbb, 0x44, 0x91, 0x44, 0xe3, 0xff, 0x51, 0x43, 0xf3, 0x50, 0xb0,
+	0x41, 0x40, 0x63, 0xc2, 0x13, 0x1d, 0x4c, 0xb8, 0x18, 0xc8, 0x89, 0x57, 0x6c, 0x15, 0xf7, 0x4a,
+	0x3d, 0x6c, 0xb1, 0x23, 0x80, 0xce, 0x01, 0xc1, 0x6f, 0x51, 0x43, 0xc7, 0x44, 0x47, 0xc1, 0x50,
+	0x11, 0x9a, 0x69, 0xd9, 0x49, 0x6f, 0xa9, 0x55, 0xdc, 0xab, 0x76, 0xda, 0x57, 0x37, 0xdb, 0x85,
+	0x2f, 0x37, 0xdb, 0xbb, 0x21, 0x37, 0xd1, 0xa8, 0xdf, 0xa6, 0x32, 0xf1, 0xa9, 0xd4, 0x89, 0xd4,
+	0xee, 0xe7, 0x3f, 0x3d, 0xb8, 0x70, 0x87, 0x79, 0xc2, 0x68, 0x0f, 0x03, 0xd7, 0x33, 0x47, 0x05,
+	0x42, 0x78, 0x82, 0x5a, 0x3f, 0x2b, 0x48, 0x31, 0x8c, 0x39, 0x35, 0x5c, 0x84, 0x4e, 0x6d, 0x79,
+	0x21, 0xb5, 0x3f, 0xef, 0xab, 0xcd, 0x59, 0xad, 0xf0, 0x3f, 0x68, 0x85, 0x4a, 0x2e, 0xb4, 0x57,
+	0x6a, 0x2d, 0xef, 0x55, 0x0e, 0xea, 0xed, 0x1f, 0xe6, 0xb7, 0x8f, 0x24, 0x17, 0x3d, 0x8b, 0xe2,
+	0x87, 0x68, 0x33, 0xe1, 0x22, 0xd0, 0x5c, 0x84, 0x31, 0x0b, 0x26, 0xdc, 0x44, 0x03, 0x45, 0x26,
+	0x41, 0x48, 0xb4, 0xb7, 0x02, 0xb6, 0x35, 0x12, 0x2e, 0x4e, 0x01, 0x3d, 0x77, 0xe0, 0x31, 0xd1,
+	0x78, 0x07, 0xd5, 0xb2, 0xb1, 0x3e, 0x31, 0x34, 0x82, 0xe6, 0x32, 0x34, 0x57, 0x12, 0x2e, 0x3a,
+	0x59, 0x2d, 0xeb, 0x79, 0x8d, 0x10, 0x95, 0x49, 0xc2, 0xb5, 0xe6, 0x52, 0x78, 0xbf, 0x2c, 0x74,
+	0xc9, 0x1c, 0x03, 0x68, 0x92, 0x69, 0x90, 0x2a, 0x4e, 0x59, 0x40, 0x42, 0xe6, 0xfd, 0xea, 0x34,
+	0xc9, 0xb4, 0x9b, 0xd5, 0x0e, 0x43, 0x96, 0x45, 0xc0, 0xe2, 0x11, 0xd7, 0x46, 0xaa, 0xcb, 0x20,
+	0x66, 0x22, 0x34, 0x91, 0xb7, 0x6a, 0x23, 0x00, 0xd8, 0x73, 0x0b, 0xbd, 0x04, 0x04, 0xef, 0xa2,
+	0xfa, 0x90, 0xb1, 0xc0, 0x4c, 0x48, 0x3a, 0xcb, 0x0b, 0x82, 0xe6, 0xda, 0x90, 0xb1, 0xb3, 0x09,
+	0x49, 0x5d, 0x54, 0x08, 0xda, 0xb0, 0x46, 0x41, 0xc0, 0x52, 0xa6, 0x66, 0xdd, 0x95, 0xc5, 0xb2,
+	0x02, 0xb6, 0x66, 0x5c, 0x5d, 0xa6, 0x9c, 0xc4, 0x39, 0xaa, 0xdb, 0xc3, 0x1b, 0x19, 0x33, 0x45,
+	0x04, 0x65, 0x5e, 0x75, 0x21, 0xf2, 0x35, 0xa0, 0x39, 0x9b, 0xb1, 0xe0, 0x0b, 0xb4, 0x95, 0x39,
+	0x97, 0x4f, 0x5e, 0xee, 0x02, 0xb5, 0x85, 0x34, 0x36, 0x13, 0x32, 0xcd, 0x85, 0x6e, 0x7e, 0x8b,
+	0x00, 0x35, 0x40, 0x2c, 0x22, 0x22, 0x64, 0xa0, 0xc3, 0x52, 0x49, 0x23, 0x6f, 0x6d, 0x21, 0x99,
+	0xf5, 0x4c, 0x06, 0xa8, 0xba, 0x4c, 0x3d, 0xcd, 0x88, 0xb2, 0x6f, 0x1c, 0x91, 0xd8, 0x04, 0x8a,
+	0x51, 0x39, 0x66, 0xea, 0xd2, 0xf2, 0x6b, 0xaf, 0x6e, 0xbf, 0x71, 0x86, 0xf5, 0x1c, 0x04, 0x03,
+	0x1a, 0x9f, 0xa0, 0xaa, 0x35, 0xf6, 0xdd, 0x48, 0xaa, 0x51, 0xe2, 0xfd, 0xb6, 0xd0, 0x51, 0x2a,
+	0xc0, 0x71, 0x02, 0x14, 0xf8, 0x2f, 0x54, 0x05, 0xd9, 0x59, 0xc0, 0xd6, 0x6d, 0x16, 0xa1, 0xe6,
+	0x92, 0xf5, 0x37, 0xaa, 0x8d, 0x25, 0x98, 0xed, 0x8c, 0xc6, 0xd0, 0x53, 0xb5, 0x45, 0xeb, 0xd6,
+	0xa3, 0xd2, 0x87, 0xaf, 0xad, 0xc2, 0xce, 0x7b, 0x54, 0x3d, 0xb6, 0xab, 0xf2, 0xd4, 0x10, 0xc3,
+	0xf0, 0xbf, 0xa8, 0x9c, 0xc2, 0x4e, 0x83, 0xdd, 0x55, 0x39, 0x58, 0xcf, 0xbd, 0x5e, 0xbb, 0xec,
+	0x7a, 0xae, 0x01, 0x3f, 0x46, 0xd5, 0xdc, 0xae, 0xd4, 0xde, 0x12, 0x3c, 0xf7, 0xdf, 0x73, 0x03,
+	0x87, 0x73, 0xb8, 0x53, 0xca, 0xee, 0xdc, 0xbb, 0x37, 0xd1, 0x79, 0x71, 0x75, 0xdb, 0x2c, 0x5e,
+	0xdf, 0x36, 0x8b, 0xdf, 0x6e, 0x9b, 0xc5, 0x8f, 0x77, 0xcd, 0xc2, 0xf5, 0x5d, 0xb3, 0xf0, 0xf9,
+	0xae, 0x59, 0x78, 0xe3, 0xe7, 0x9c, 0x79, 0xc5, 0x85, 0x61, 0xea, 0x8c, 0x91, 0xc4, 0x4f, 0xa2,
+	0x51, 0xdf, 0xa7, 0x11, 0xe1, 0xc2, 0x9f, 0xfa, 0x6e, 0x2b, 0x83, 0x4d, 0xfd, 0x32, 0xec, 0xe4,
+	0x07, 0xdf, 0x07, 0x00, 0xc6, 0x3d, 0x13, 0x86, 0x03, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -209,6 +287,92 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VotingWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.VotingWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.PriceQuorum.Size()
+		i -= size
+		if _, err := m.PriceQuorum.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.HaltRecoveryEpochs != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HaltRecoveryEpochs))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.MaxChangePerEpoch.Size()
+		i -= size
+		if _, err := m.MaxChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxConflictingPerWindow.Size()
+		i -= size
+		if _, err := m.MaxConflictingPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.PriceTolerance.Size()
+		i -= size
+		if _, err := m.PriceTolerance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.FeeTwapWindow != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FeeTwapWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PriceHistoryLength != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PriceHistoryLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.Commission.Size()
 		i -= size
@@ -358,6 +522,34 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.Commission.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxPriceAge))
+	}
+	if m.PriceHistoryLength != 0 {
+		n += 1 + sovGenesis(uint64(m.PriceHistoryLength))
+	}
+	if m.FeeTwapWindow != 0 {
+		n += 1 + sovGenesis(uint64(m.FeeTwapWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.PriceTolerance.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxConflictingPerWindow.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxChangePerEpoch.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.HaltRecoveryEpochs != 0 {
+		n += 1 + sovGenesis(uint64(m.HaltRecoveryEpochs))
+	}
+	l = m.PriceQuorum.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	if m.EpochLength != 0 {
+		n += 2 + sovGenesis(uint64(m.EpochLength))
+	}
+	if m.VotingWindow != 0 {
+		n += 2 + sovGenesis(uint64(m.VotingWindow))
+	}
 	return n
 }
 
@@ -605,6 +797,285 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceHistoryLength", wireType)
+			}
+			m.PriceHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTwapWindow", wireType)
+			}
+			m.FeeTwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTolerance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceTolerance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConflictingPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxConflictingPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerEpoch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltRecoveryEpochs", wireType)
+			}
+			m.HaltRecoveryEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltRecoveryEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceQuorum", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceQuorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingWindow", wireType)
+			}
+			m.VotingWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
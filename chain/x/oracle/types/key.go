@@ -40,6 +40,16 @@ var (
 	CurrentPricesKey = []byte{0x4}
 
 	TxStatusKey = []byte{0x5}
+
+	PriceUpdateKey = []byte{0x6}
+
+	PriceHistoryKey = []byte{0x7}
+
+	VotingInfoKey = []byte{0x8}
+
+	PriceHaltKey = []byte{0x9}
+
+	EpochStartHeightKey = []byte{0xa}
 )
 
 // GetClaimKey returns the following key format
@@ -108,3 +118,38 @@ func GetAttestationKeyWithHash(epoch uint64, claimHash []byte) []byte {
 func GetTxStatusKey(inTxHash string) []byte {
 	return append(TxStatusKey, []byte(inTxHash)...)
 }
+
+// GetPriceUpdateKey returns the following key format
+// prefix    name
+// [0x6][eth/0]
+func GetPriceUpdateKey(name string) []byte {
+	return append(PriceUpdateKey, []byte(name)...)
+}
+
+// GetPriceHistoryPrefix returns the following key format
+// prefix    name length    name
+// [0x7][5][eth/0]
+func GetPriceHistoryPrefix(name string) []byte {
+	return append(append(PriceHistoryKey, byte(len(name))), []byte(name)...)
+}
+
+// GetPriceHistoryKey returns the following key format
+// prefix    name length    name    epoch
+// [0x7][5][eth/0][0 0 0 0 0 0 0 1]
+func GetPriceHistoryKey(name string, epoch uint64) []byte {
+	return append(GetPriceHistoryPrefix(name), UInt64Bytes(epoch)...)
+}
+
+// GetVotingInfoKey returns the following key format
+// prefix    validator-address
+// [0x8][cosmosvaloper1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetVotingInfoKey(validator sdk.ValAddress) []byte {
+	return append(VotingInfoKey, validator.Bytes()...)
+}
+
+// GetPriceHaltKey returns the following key format
+// prefix    name
+// [0x9][eth/0]
+func GetPriceHaltKey(name string) []byte {
+	return append(PriceHaltKey, []byte(name)...)
+}
@@ -91,6 +91,20 @@ func (e *MsgPriceClaim) ValidateBasic() error {
 	if e.Epoch == 0 {
 		return fmt.Errorf("nonce == 0")
 	}
+
+	seen := map[string]bool{}
+	for _, price := range e.GetPrices().GetList() {
+		if price.Name == "" {
+			return sdkerrors.Wrap(ErrEmpty, "price name")
+		}
+		if seen[price.Name] {
+			return sdkerrors.Wrapf(ErrDuplicate, "price: %s", price.Name)
+		}
+		if price.Value.IsNil() {
+			return sdkerrors.Wrapf(ErrEmpty, "price value: %s", price.Name)
+		}
+		seen[price.Name] = true
+	}
 	return nil
 }
 
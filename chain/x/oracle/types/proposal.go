@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeClearPriceHalt defines the type for a ClearPriceHaltProposal
+	ProposalTypeClearPriceHalt = "ClearPriceHalt"
+)
+
+// Assert ClearPriceHaltProposal implements govtypes.Content at compile-time
+var _ govtypes.Content = &ClearPriceHaltProposal{}
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeClearPriceHalt)
+	govtypes.RegisterProposalTypeCodec(&ClearPriceHaltProposal{}, "oracle/ClearPriceHaltProposal")
+}
+
+func NewClearPriceHaltProposal(title string, description string, names []string) *ClearPriceHaltProposal {
+	return &ClearPriceHaltProposal{title, description, names}
+}
+
+// GetTitle returns the title of a clear price halt proposal.
+func (p *ClearPriceHaltProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a clear price halt proposal.
+func (p *ClearPriceHaltProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a clear price halt proposal.
+func (p *ClearPriceHaltProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a clear price halt proposal.
+func (p *ClearPriceHaltProposal) ProposalType() string { return ProposalTypeClearPriceHalt }
+
+// ValidateBasic runs basic stateless validity checks
+func (p *ClearPriceHaltProposal) ValidateBasic() error {
+	if err := govtypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if len(p.Names) == 0 {
+		return sdkerrors.Wrap(ErrEmpty, "price names")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p ClearPriceHaltProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Clear Price Halt Proposal:
+  Title:       %s
+  Description: %s
+  Names:       %s`, p.Title, p.Description, strings.Join(p.Names, ", ")))
+	return b.String()
+}
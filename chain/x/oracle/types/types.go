@@ -7,6 +7,33 @@ import (
 	"strconv"
 )
 
+const (
+	// DefaultPricePrecision is the number of decimals in the prices of eth and of the coins without price precision
+	DefaultPricePrecision = 10
+
+	// MaxPricePrecision is the max number of decimals in the prices
+	MaxPricePrecision = sdk.Precision
+
+	// PriceNameEth is the name of the USD price of ether
+	PriceNameEth = "eth/0"
+
+	// PriceNameEthBaseFee is the name of the Ethereum base fee per gas in gwei
+	PriceNameEthBaseFee = "eth/base_fee_gwei"
+
+	// PriceNameEthPriorityFee is the name of the Ethereum priority fee per gas in gwei
+	PriceNameEthPriorityFee = "eth/priority_fee_gwei"
+
+	// PriceNameEthGas is the name of the Ethereum gas price in tenths of gwei, which the oracles voted for before the
+	// base and priority fees. It is derived from the fees for the clients which still read it.
+	// Deprecated: will be removed in the next release, use PriceNameEthBaseFee and PriceNameEthPriorityFee.
+	PriceNameEthGas = "eth/gas"
+
+	// legacyGasUnits is the number of the eth/gas units in a gwei
+	legacyGasUnits = 10
+
+	gweiInEth = 1e9
+)
+
 // UInt64FromBytes create uint from binary big endian representation
 func UInt64FromBytes(s []byte) uint64 {
 	return binary.BigEndian.Uint64(s)
@@ -22,6 +49,35 @@ func UInt64FromString(s string) (uint64, error) {
 	return strconv.ParseUint(s, 10, 64)
 }
 
+// EffectivePricePrecision returns the number of decimals in the price of the coin
+func (m *Coin) EffectivePricePrecision() uint64 {
+	if m.PricePrecision == 0 {
+		return DefaultPricePrecision
+	}
+
+	return m.PricePrecision
+}
+
+// PriceToDec converts the price voted by the oracles with the given precision into USD
+func PriceToDec(value sdk.Int, precision uint64) sdk.Dec {
+	return sdk.NewDecFromBigIntWithPrec(value.BigInt(), int64(precision))
+}
+
+// GasCostUSD returns the USD cost of gasUnits of gas at the given gas price in gwei
+func GasCostUSD(ethPrice sdk.Dec, gasPriceGwei sdk.Dec, gasUnits uint64) sdk.Dec {
+	return ethPrice.Mul(gasPriceGwei).MulInt64(int64(gasUnits)).QuoInt64(gweiInEth)
+}
+
+// LegacyGasPrice returns the value of the deprecated eth/gas price for the given base and priority fees in gwei
+func LegacyGasPrice(baseFee sdk.Dec, priorityFee sdk.Dec) sdk.Int {
+	return baseFee.Add(priorityFee).MulInt64(legacyGasUnits).TruncateInt()
+}
+
+// PriceFromDec converts the USD price into the value voted by the oracles with the given precision
+func PriceFromDec(price sdk.Dec, precision uint64) sdk.Int {
+	return price.Mul(sdk.NewDecFromInt(sdk.NewIntWithDecimal(1, int(precision)))).TruncateInt()
+}
+
 type Coins struct {
 	list []*Coin
 }
@@ -53,14 +109,23 @@ func (c Coins) GetDenomByEthereumAddress(address string) (string, error) {
 	return coin.Denom, nil
 }
 
-func (c Coins) GetDenomByMinterId(id uint64) (string, error) {
+func (c Coins) GetByMinterId(id uint64) (*Coin, error) {
 	for _, coin := range c.list {
 		if coin.MinterId == int64(id) {
-			return coin.Denom, nil
+			return coin, nil
 		}
 	}
 
-	return "", errors.New("coin not found")
+	return nil, errors.New("coin not found")
+}
+
+func (c Coins) GetDenomByMinterId(id uint64) (string, error) {
+	coin, err := c.GetByMinterId(id)
+	if err != nil {
+		return "", err
+	}
+
+	return coin.Denom, nil
 }
 
 func (c Coins) GetMinterIdByDenom(denom string) (uint64, error) {
@@ -8,15 +8,19 @@ import (
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -277,6 +281,10 @@ type Coin struct {
 	MinterId         int64                                   `protobuf:"varint,3,opt,name=minter_id,json=minterId,proto3" json:"minter_id"`
 	EthDecimals      uint64                                  `protobuf:"varint,4,opt,name=eth_decimals,json=ethDecimals,proto3" json:"eth_decimals,omitempty"`
 	CustomCommission *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=custom_commission,json=customCommission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"custom_commission,omitempty"`
+	// number of decimals in the price of the coin, the default precision is used if not set
+	PricePrecision uint64 `protobuf:"varint,6,opt,name=price_precision,json=pricePrecision,proto3" json:"price_precision,omitempty"`
+	// max relative change of the price of the coin in an epoch, the default max change is used if not set
+	MaxChangePerEpoch *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=max_change_per_epoch,json=maxChangePerEpoch,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_per_epoch,omitempty"`
 }
 
 func (m *Coin) Reset()         { *m = Coin{} }
@@ -340,6 +348,328 @@ func (m *Coin) GetEthDecimals() uint64 {
 	return 0
 }
 
+func (m *Coin) GetPricePrecision() uint64 {
+	if m != nil {
+		return m.PricePrecision
+	}
+	return 0
+}
+
+// PriceUpdate records when the price was last updated by the oracles
+type PriceUpdate struct {
+	Name   string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Epoch  uint64    `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// set when the price did not reach the voting power quorum in the last processed epoch and kept its previous value
+	MissingQuorum bool `protobuf:"varint,5,opt,name=missing_quorum,json=missingQuorum,proto3" json:"missing_quorum,omitempty"`
+}
+
+func (m *PriceUpdate) Reset()         { *m = PriceUpdate{} }
+func (m *PriceUpdate) String() string { return proto.CompactTextString(m) }
+func (*PriceUpdate) ProtoMessage()    {}
+func (*PriceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54af2de77c923e3, []int{4}
+}
+func (m *PriceUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceUpdate.Merge(m, src)
+}
+func (m *PriceUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceUpdate proto.InternalMessageInfo
+
+func (m *PriceUpdate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PriceUpdate) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PriceUpdate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceUpdate) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *PriceUpdate) GetMissingQuorum() bool {
+	if m != nil {
+		return m.MissingQuorum
+	}
+	return false
+}
+
+// PriceSnapshot is the price stored by the oracles in an epoch
+type PriceSnapshot struct {
+	Epoch  uint64                                 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Height int64                                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time                              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	Value  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"value"`
+}
+
+func (m *PriceSnapshot) Reset()         { *m = PriceSnapshot{} }
+func (m *PriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*PriceSnapshot) ProtoMessage()    {}
+func (*PriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54af2de77c923e3, []int{5}
+}
+func (m *PriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSnapshot.Merge(m, src)
+}
+func (m *PriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSnapshot proto.InternalMessageInfo
+
+func (m *PriceSnapshot) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PriceSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PriceSnapshot) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// VotingInfo counts the price votes of a validator in the current signed claims window
+type VotingInfo struct {
+	Validator        string `protobuf:"bytes,1,opt,name=validator,proto3" json:"validator,omitempty"`
+	WindowStartEpoch uint64 `protobuf:"varint,2,opt,name=window_start_epoch,json=windowStartEpoch,proto3" json:"window_start_epoch,omitempty"`
+	MissedVotes      uint64 `protobuf:"varint,3,opt,name=missed_votes,json=missedVotes,proto3" json:"missed_votes,omitempty"`
+	ConflictingVotes uint64 `protobuf:"varint,4,opt,name=conflicting_votes,json=conflictingVotes,proto3" json:"conflicting_votes,omitempty"`
+}
+
+func (m *VotingInfo) Reset()         { *m = VotingInfo{} }
+func (m *VotingInfo) String() string { return proto.CompactTextString(m) }
+func (*VotingInfo) ProtoMessage()    {}
+func (*VotingInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54af2de77c923e3, []int{6}
+}
+func (m *VotingInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VotingInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingInfo.Merge(m, src)
+}
+func (m *VotingInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VotingInfo proto.InternalMessageInfo
+
+func (m *VotingInfo) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+func (m *VotingInfo) GetWindowStartEpoch() uint64 {
+	if m != nil {
+		return m.WindowStartEpoch
+	}
+	return 0
+}
+
+func (m *VotingInfo) GetMissedVotes() uint64 {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return 0
+}
+
+func (m *VotingInfo) GetConflictingVotes() uint64 {
+	if m != nil {
+		return m.ConflictingVotes
+	}
+	return 0
+}
+
+// PriceHalt marks the price whose median moved more than allowed in an epoch. The halted price is not updated until
+// the medians are stable again or the halt is cleared by governance.
+type PriceHalt struct {
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// last applied value of the price
+	LastValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=last_value,json=lastValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"last_value"`
+	// last rejected median of the price
+	CandidateValue github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=candidate_value,json=candidateValue,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"candidate_value"`
+	// number of consecutive epochs the rejected medians stayed within the max change
+	StableEpochs uint64 `protobuf:"varint,5,opt,name=stable_epochs,json=stableEpochs,proto3" json:"stable_epochs,omitempty"`
+}
+
+func (m *PriceHalt) Reset()         { *m = PriceHalt{} }
+func (m *PriceHalt) String() string { return proto.CompactTextString(m) }
+func (*PriceHalt) ProtoMessage()    {}
+func (*PriceHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54af2de77c923e3, []int{7}
+}
+func (m *PriceHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceHalt.Merge(m, src)
+}
+func (m *PriceHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceHalt proto.InternalMessageInfo
+
+func (m *PriceHalt) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PriceHalt) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *PriceHalt) GetStableEpochs() uint64 {
+	if m != nil {
+		return m.StableEpochs
+	}
+	return 0
+}
+
+// ClearPriceHaltProposal applies the last rejected medians of the halted prices and resumes them
+type ClearPriceHaltProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Names       []string `protobuf:"bytes,3,rep,name=names,proto3" json:"names,omitempty"`
+}
+
+func (m *ClearPriceHaltProposal) Reset()      { *m = ClearPriceHaltProposal{} }
+func (*ClearPriceHaltProposal) ProtoMessage() {}
+func (*ClearPriceHaltProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b54af2de77c923e3, []int{8}
+}
+func (m *ClearPriceHaltProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClearPriceHaltProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClearPriceHaltProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClearPriceHaltProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearPriceHaltProposal.Merge(m, src)
+}
+func (m *ClearPriceHaltProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClearPriceHaltProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearPriceHaltProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearPriceHaltProposal proto.InternalMessageInfo
+
 type TxStatus struct {
 	InTxHash  string       `protobuf:"bytes,1,opt,name=in_tx_hash,json=inTxHash,proto3" json:"in_tx_hash"`
 	OutTxHash string       `protobuf:"bytes,2,opt,name=out_tx_hash,json=outTxHash,proto3" json:"out_tx_hash"`
@@ -350,7 +680,7 @@ func (m *TxStatus) Reset()         { *m = TxStatus{} }
 func (m *TxStatus) String() string { return proto.CompactTextString(m) }
 func (*TxStatus) ProtoMessage()    {}
 func (*TxStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b54af2de77c923e3, []int{4}
+	return fileDescriptor_b54af2de77c923e3, []int{9}
 }
 func (m *TxStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -406,59 +736,90 @@ func init() {
 	proto.RegisterType((*Epoch)(nil), "oracle.v1.Epoch")
 	proto.RegisterType((*Vote)(nil), "oracle.v1.Vote")
 	proto.RegisterType((*Coin)(nil), "oracle.v1.Coin")
+	proto.RegisterType((*PriceUpdate)(nil), "oracle.v1.PriceUpdate")
+	proto.RegisterType((*PriceSnapshot)(nil), "oracle.v1.PriceSnapshot")
+	proto.RegisterType((*VotingInfo)(nil), "oracle.v1.VotingInfo")
+	proto.RegisterType((*PriceHalt)(nil), "oracle.v1.PriceHalt")
+	proto.RegisterType((*ClearPriceHaltProposal)(nil), "oracle.v1.ClearPriceHaltProposal")
 	proto.RegisterType((*TxStatus)(nil), "oracle.v1.TxStatus")
 }
 
 func init() { proto.RegisterFile("oracle/v1/types.proto", fileDescriptor_b54af2de77c923e3) }
 
 var fileDescriptor_b54af2de77c923e3 = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4f, 0x8f, 0xdb, 0x44,
-	0x14, 0x8f, 0x37, 0xce, 0x36, 0x79, 0x49, 0xdb, 0x30, 0x84, 0xae, 0x31, 0xe0, 0x98, 0x20, 0x50,
-	0x54, 0x81, 0x4d, 0x97, 0x0b, 0xa2, 0x12, 0x52, 0xfc, 0xa7, 0x6c, 0x0e, 0xcd, 0x56, 0x13, 0xa7,
-	0xaa, 0xe0, 0x60, 0x79, 0xed, 0x51, 0x6c, 0xb1, 0xf6, 0x44, 0xf6, 0x24, 0x4a, 0xbf, 0x01, 0xca,
-	0x89, 0x2f, 0x90, 0x13, 0x12, 0x27, 0x3e, 0x05, 0xa7, 0x1e, 0x7b, 0x44, 0x1c, 0x22, 0xb4, 0xb9,
-	0xe5, 0x53, 0x20, 0x8f, 0xdd, 0x24, 0xaa, 0xda, 0x3d, 0xf9, 0xbd, 0xdf, 0xef, 0xf7, 0xde, 0xbc,
-	0x79, 0xfe, 0x69, 0xe0, 0x23, 0x9a, 0x7a, 0xfe, 0x35, 0xd1, 0x17, 0x8f, 0x74, 0xf6, 0x72, 0x46,
-	0x32, 0x6d, 0x96, 0x52, 0x46, 0x51, 0xa3, 0x80, 0xb5, 0xc5, 0x23, 0xb9, 0x73, 0x50, 0xc4, 0xd9,
-	0xb4, 0x14, 0xc8, 0x9d, 0x29, 0x9d, 0x52, 0x1e, 0xea, 0x79, 0x54, 0xa0, 0xbd, 0xbf, 0x05, 0x68,
-	0xfd, 0x44, 0x12, 0x92, 0x46, 0xbe, 0x79, 0xed, 0x45, 0x31, 0xea, 0x40, 0x8d, 0xcc, 0xa8, 0x1f,
-	0x4a, 0x82, 0x2a, 0xf4, 0x45, 0x5c, 0x24, 0xe8, 0x33, 0x00, 0x3f, 0xa7, 0xdd, 0xfc, 0x48, 0xe9,
-	0x44, 0x15, 0xfa, 0x35, 0xdc, 0xe0, 0x88, 0xf3, 0x72, 0x46, 0x10, 0x02, 0x31, 0xf4, 0xb2, 0x50,
-	0xaa, 0xaa, 0x42, 0xbf, 0x85, 0x79, 0x8c, 0xbe, 0x80, 0xbb, 0x64, 0x41, 0x12, 0xe6, 0x72, 0x19,
-	0x49, 0x25, 0x51, 0x15, 0xfa, 0x0d, 0xdc, 0xe2, 0xa0, 0x59, 0x60, 0xe8, 0x31, 0x34, 0x67, 0x69,
-	0xe4, 0x93, 0x42, 0x24, 0xd5, 0x54, 0xa1, 0xdf, 0x3c, 0x97, 0xb4, 0xfd, 0x5d, 0xb4, 0xa7, 0xd9,
-	0xf4, 0x59, 0x2e, 0xe0, 0x05, 0x17, 0x15, 0x0c, 0xb3, 0x7d, 0x66, 0xdc, 0x81, 0x1a, 0x2f, 0xeb,
-	0x59, 0x50, 0xb3, 0xf9, 0x98, 0x1d, 0xa8, 0x25, 0x34, 0xf1, 0xc9, 0x9b, 0xe1, 0x79, 0x82, 0xbe,
-	0x84, 0xda, 0x82, 0x32, 0x92, 0x49, 0x27, 0x6a, 0xb5, 0xdf, 0x3c, 0xbf, 0x7f, 0xd4, 0xfe, 0x39,
-	0x65, 0x04, 0x17, 0x6c, 0x6f, 0x04, 0x62, 0x9e, 0xa2, 0x07, 0x70, 0x5a, 0x08, 0x78, 0x97, 0x06,
-	0x2e, 0x33, 0xa4, 0x95, 0xc7, 0xf1, 0xeb, 0xdf, 0x32, 0x25, 0x2e, 0xa7, 0xda, 0x0a, 0x20, 0x9a,
-	0x34, 0x4a, 0xf2, 0xa9, 0x02, 0x92, 0xd0, 0xb8, 0xec, 0x57, 0x24, 0xe8, 0x63, 0xa8, 0x13, 0x16,
-	0xba, 0x5e, 0x10, 0xa4, 0xbc, 0x63, 0x03, 0xdf, 0x21, 0x2c, 0x1c, 0x04, 0x41, 0x8a, 0x1e, 0x42,
-	0x23, 0x8e, 0x12, 0x46, 0x52, 0x37, 0x0a, 0xf8, 0x4e, 0xab, 0xc6, 0xdd, 0xdd, 0xa6, 0x7b, 0x00,
-	0x71, 0xbd, 0x08, 0x87, 0x01, 0xfa, 0x1c, 0x5a, 0x79, 0x9b, 0x80, 0xf8, 0x51, 0xec, 0x5d, 0x67,
-	0x7c, 0xcb, 0x22, 0x6e, 0x12, 0x16, 0x5a, 0x25, 0x84, 0x7e, 0x81, 0x0f, 0xfc, 0x79, 0xc6, 0x68,
-	0xec, 0xfa, 0x34, 0x8e, 0xa3, 0x2c, 0x8b, 0x68, 0xc2, 0x57, 0xdd, 0x32, 0xb4, 0x57, 0x9b, 0xae,
-	0xf0, 0xef, 0xa6, 0xfb, 0xd5, 0x34, 0x62, 0xe1, 0xfc, 0x4a, 0xf3, 0x69, 0xac, 0xfb, 0x34, 0x8b,
-	0x69, 0x56, 0x7e, 0xbe, 0xc9, 0x82, 0x5f, 0x4b, 0x9f, 0x59, 0xc4, 0xc7, 0xed, 0xa2, 0x91, 0xb9,
-	0xef, 0xd3, 0xfb, 0x53, 0x80, 0xba, 0xb3, 0x1c, 0x33, 0x8f, 0xcd, 0x33, 0xf4, 0x35, 0x40, 0x94,
-	0xb8, 0x6c, 0xe9, 0x72, 0x37, 0xf0, 0xeb, 0x1a, 0xf7, 0x76, 0x9b, 0xee, 0x11, 0x8a, 0xeb, 0x51,
-	0xe2, 0x2c, 0x2f, 0x72, 0x87, 0xe8, 0xd0, 0xa4, 0x73, 0xb6, 0x97, 0xf3, 0x25, 0x18, 0xf7, 0x77,
-	0x9b, 0xee, 0x31, 0x8c, 0x1b, 0x74, 0xce, 0xca, 0x82, 0xc7, 0x70, 0x9a, 0xf1, 0x83, 0xf8, 0x52,
-	0xee, 0x9d, 0x9f, 0x1d, 0xfd, 0x82, 0x37, 0x33, 0xe4, 0x7e, 0x34, 0x60, 0xb7, 0xe9, 0x96, 0x52,
-	0x5c, 0x7e, 0x1f, 0xfe, 0x75, 0x02, 0xad, 0x63, 0x11, 0xfa, 0x16, 0x3e, 0x74, 0x5e, 0xb8, 0x63,
-	0x67, 0xe0, 0x4c, 0xc6, 0xee, 0xe8, 0xd2, 0x71, 0x9f, 0x5c, 0x4e, 0x46, 0x56, 0xbb, 0x22, 0x9f,
-	0xad, 0xd6, 0xea, 0xbb, 0x28, 0xf4, 0x23, 0xc8, 0x07, 0xd8, 0xb2, 0x9f, 0x5d, 0x8e, 0x87, 0x8e,
-	0x8b, 0x6d, 0xd3, 0x1e, 0x3e, 0xb7, 0xad, 0xb6, 0x20, 0x2b, 0xab, 0xb5, 0x7a, 0x8b, 0x02, 0x7d,
-	0x0f, 0x67, 0x07, 0xd6, 0x18, 0x38, 0xe6, 0x85, 0x6b, 0x62, 0x7b, 0xe0, 0xd8, 0x56, 0xfb, 0x44,
-	0xfe, 0x64, 0xb5, 0x56, 0xdf, 0x47, 0xa3, 0x1f, 0x40, 0x7a, 0x9b, 0xb2, 0x5f, 0xd8, 0xe6, 0x24,
-	0x2f, 0xad, 0xca, 0x9f, 0xae, 0xd6, 0xea, 0x7b, 0x79, 0xa4, 0x01, 0x3a, 0x70, 0xd8, 0x7e, 0x32,
-	0x19, 0x59, 0xb6, 0xd5, 0x16, 0xe5, 0x07, 0xab, 0xb5, 0xfa, 0x0e, 0x46, 0x16, 0x7f, 0xfb, 0x43,
-	0xa9, 0x18, 0xc3, 0x57, 0x37, 0x8a, 0xf0, 0xfa, 0x46, 0x11, 0xfe, 0xbb, 0x51, 0x84, 0xdf, 0xb7,
-	0x4a, 0xe5, 0xf5, 0x56, 0xa9, 0xfc, 0xb3, 0x55, 0x2a, 0x3f, 0xeb, 0x47, 0x5e, 0x79, 0xca, 0x6d,
-	0xe8, 0x10, 0x2f, 0xd6, 0xe3, 0x70, 0x7e, 0xa5, 0xfb, 0xa1, 0x17, 0x25, 0xfa, 0x52, 0x2f, 0x5f,
-	0x20, 0x6e, 0x9c, 0xab, 0x53, 0xfe, 0xd4, 0x7c, 0xf7, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x33,
-	0x7a, 0x6a, 0x5b, 0xba, 0x04, 0x00, 0x00,
+	// 1151 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcd, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0xf3, 0xb1, 0xdd, 0xbc, 0x64, 0x77, 0xd3, 0x61, 0xd9, 0x86, 0x50, 0x62, 0x37, 0x55,
+	0x21, 0x2a, 0x25, 0xa6, 0xcb, 0xa5, 0x6a, 0x25, 0xa4, 0xcd, 0x47, 0xd9, 0x1c, 0xba, 0x5d, 0x1c,
+	0xef, 0x52, 0xc1, 0xc1, 0x9a, 0xb5, 0xa7, 0xb1, 0x85, 0xed, 0x31, 0x9e, 0x49, 0x9a, 0x5e, 0x39,
+	0x55, 0x7b, 0xea, 0x91, 0xcb, 0x4a, 0x95, 0x90, 0x10, 0x07, 0x0e, 0xfc, 0x03, 0x5c, 0x38, 0xf5,
+	0xd8, 0x23, 0xe2, 0x10, 0x50, 0x7b, 0x41, 0x2b, 0xf1, 0x3f, 0x20, 0xcf, 0x38, 0x1f, 0x42, 0xdd,
+	0x8a, 0x96, 0x53, 0xe6, 0xfd, 0xde, 0x87, 0x7f, 0xfe, 0xbd, 0xf7, 0xc6, 0x81, 0xb7, 0x69, 0x8c,
+	0x6d, 0x9f, 0xe8, 0xe3, 0xeb, 0x3a, 0x7f, 0x18, 0x11, 0xd6, 0x8a, 0x62, 0xca, 0x29, 0x2a, 0x4a,
+	0xb8, 0x35, 0xbe, 0x5e, 0xdb, 0x5c, 0x44, 0x04, 0x6c, 0x98, 0x06, 0xd4, 0x36, 0x87, 0x74, 0x48,
+	0xc5, 0x51, 0x4f, 0x4e, 0x29, 0xaa, 0x0e, 0x29, 0x1d, 0xfa, 0x44, 0x17, 0xd6, 0xd1, 0xe8, 0xbe,
+	0xce, 0xbd, 0x80, 0x30, 0x8e, 0x83, 0x48, 0x06, 0x34, 0x7e, 0x55, 0xa0, 0xfc, 0x19, 0x09, 0x49,
+	0xec, 0xd9, 0x1d, 0x1f, 0x7b, 0x01, 0xda, 0x84, 0x02, 0x89, 0xa8, 0xed, 0x56, 0x15, 0x4d, 0x69,
+	0xe6, 0x0d, 0x69, 0xa0, 0xf7, 0x00, 0xec, 0xc4, 0x6d, 0x25, 0x9c, 0xaa, 0x59, 0x4d, 0x69, 0x16,
+	0x8c, 0xa2, 0x40, 0xcc, 0x87, 0x11, 0x41, 0x08, 0xf2, 0x2e, 0x66, 0x6e, 0x35, 0xa7, 0x29, 0xcd,
+	0xb2, 0x21, 0xce, 0xe8, 0x32, 0xac, 0x91, 0x31, 0x09, 0xb9, 0x25, 0xc2, 0x48, 0x5c, 0xcd, 0x6b,
+	0x4a, 0xb3, 0x68, 0x94, 0x05, 0xd8, 0x91, 0x18, 0xba, 0x05, 0xa5, 0x28, 0xf6, 0x6c, 0x22, 0x83,
+	0xaa, 0x05, 0x4d, 0x69, 0x96, 0xb6, 0xab, 0xad, 0xf9, 0xcb, 0xb6, 0xee, 0xb0, 0xe1, 0x7e, 0x12,
+	0x20, 0x12, 0x76, 0x33, 0x06, 0x44, 0x73, 0xab, 0x7d, 0x0e, 0x0a, 0x22, 0xad, 0xd1, 0x85, 0x42,
+	0x4f, 0xd0, 0xdc, 0x84, 0x42, 0x48, 0x43, 0x9b, 0xcc, 0xc8, 0x0b, 0x03, 0x5d, 0x81, 0xc2, 0x98,
+	0x72, 0xc2, 0xaa, 0x59, 0x2d, 0xd7, 0x2c, 0x6d, 0x6f, 0x2c, 0x95, 0x3f, 0xa4, 0x9c, 0x18, 0xd2,
+	0xdb, 0xd8, 0x83, 0x7c, 0x62, 0xa2, 0x2d, 0x58, 0x91, 0x01, 0xa2, 0x4a, 0xd1, 0x48, 0x2d, 0xd4,
+	0x4a, 0x1f, 0x57, 0xcd, 0xbe, 0x9a, 0xa5, 0x91, 0xb2, 0xfa, 0x3b, 0x0b, 0xf9, 0x0e, 0xf5, 0xc2,
+	0x84, 0x95, 0x43, 0x42, 0x1a, 0xa4, 0xf5, 0xa4, 0x81, 0xde, 0x81, 0x55, 0xc2, 0x5d, 0x0b, 0x3b,
+	0x4e, 0x2c, 0x2a, 0x16, 0x8d, 0x73, 0x84, 0xbb, 0x3b, 0x8e, 0x13, 0xa3, 0xab, 0x50, 0x0c, 0xbc,
+	0x90, 0x93, 0xd8, 0xf2, 0x1c, 0xa1, 0x69, 0xae, 0xbd, 0x76, 0x3a, 0x55, 0x17, 0xa0, 0xb1, 0x2a,
+	0x8f, 0x7d, 0x07, 0x5d, 0x82, 0x72, 0x52, 0xc6, 0x21, 0xb6, 0x17, 0x60, 0x9f, 0x09, 0x95, 0xf3,
+	0x46, 0x89, 0x70, 0xb7, 0x9b, 0x42, 0xe8, 0x2b, 0x38, 0x6f, 0x8f, 0x18, 0xa7, 0x81, 0x65, 0xd3,
+	0x20, 0xf0, 0x18, 0xf3, 0x68, 0x28, 0xa4, 0x2e, 0xb7, 0x5b, 0x4f, 0xa7, 0xaa, 0xf2, 0xfb, 0x54,
+	0x7d, 0x7f, 0xe8, 0x71, 0x77, 0x74, 0xd4, 0xb2, 0x69, 0xa0, 0xdb, 0x94, 0x05, 0x94, 0xa5, 0x3f,
+	0x1f, 0x31, 0xe7, 0xeb, 0x74, 0x10, 0xbb, 0xc4, 0x36, 0x2a, 0xb2, 0x50, 0x67, 0x5e, 0x07, 0x7d,
+	0x00, 0x1b, 0xb2, 0x83, 0x51, 0x4c, 0x6c, 0x4f, 0x94, 0x5e, 0x11, 0x14, 0xd6, 0x05, 0xbc, 0x3f,
+	0x43, 0x91, 0x05, 0x9b, 0x01, 0x9e, 0x58, 0xb6, 0x8b, 0xc3, 0x21, 0xb1, 0x22, 0x12, 0x5b, 0x72,
+	0xce, 0xce, 0xbd, 0x11, 0x91, 0xf3, 0x01, 0x9e, 0x74, 0x44, 0xa9, 0x7d, 0x12, 0x8b, 0xe6, 0x37,
+	0x7e, 0x56, 0xa0, 0x24, 0xba, 0x70, 0x10, 0x39, 0x98, 0x8b, 0xa1, 0x0c, 0x71, 0x30, 0xeb, 0xa2,
+	0x38, 0x2f, 0xa6, 0x3b, 0xbb, 0x3c, 0xdd, 0x5b, 0xb0, 0xe2, 0x12, 0x6f, 0xe8, 0x72, 0x29, 0xb6,
+	0x91, 0x5a, 0xe8, 0x06, 0xe4, 0x93, 0x7d, 0x11, 0x9a, 0x96, 0xb6, 0x6b, 0x2d, 0xb9, 0x4c, 0xad,
+	0xd9, 0x32, 0xb5, 0xcc, 0xd9, 0x32, 0xb5, 0x57, 0x9f, 0x4e, 0xd5, 0xcc, 0xe3, 0x3f, 0x54, 0xc5,
+	0x10, 0x19, 0xe8, 0x0a, 0xac, 0x0b, 0x81, 0xc2, 0xa1, 0xf5, 0xcd, 0x88, 0xc6, 0x23, 0x39, 0xda,
+	0xab, 0xc6, 0x5a, 0x8a, 0x7e, 0x2e, 0xc0, 0xc6, 0x2f, 0x0a, 0xac, 0x09, 0xca, 0x83, 0x10, 0x47,
+	0xcc, 0xa5, 0xfc, 0x8c, 0xf5, 0x5b, 0x10, 0xcc, 0xbe, 0x94, 0x60, 0xee, 0xb5, 0x09, 0x76, 0xa1,
+	0x30, 0xc6, 0xfe, 0x48, 0xbe, 0x5b, 0x51, 0xc8, 0x9f, 0xf9, 0x8f, 0xf2, 0xf7, 0x43, 0x6e, 0xc8,
+	0xe4, 0xc6, 0x8f, 0x0a, 0xc0, 0x21, 0xe5, 0x5e, 0x38, 0xec, 0x87, 0xf7, 0x29, 0xba, 0x08, 0xc5,
+	0x31, 0xf6, 0x3d, 0x07, 0x73, 0x1a, 0xa7, 0xb2, 0x2f, 0x00, 0x74, 0x0d, 0xd0, 0x03, 0x2f, 0x74,
+	0xe8, 0x03, 0x8b, 0x71, 0x1c, 0x73, 0x6b, 0xb9, 0x11, 0x15, 0xe9, 0x19, 0x24, 0x0e, 0xb9, 0xca,
+	0x97, 0xa0, 0x9c, 0x68, 0x45, 0x1c, 0x4b, 0xee, 0x6e, 0x4e, 0xce, 0xb5, 0xc4, 0x92, 0x3d, 0x65,
+	0xe8, 0x43, 0x38, 0x6f, 0xd3, 0xf0, 0xbe, 0xef, 0xd9, 0x09, 0x83, 0x34, 0x4e, 0xce, 0x7f, 0x65,
+	0xc9, 0x21, 0x82, 0x1b, 0xdf, 0x66, 0xa1, 0x28, 0xa4, 0xde, 0xc5, 0x3e, 0x7f, 0x8d, 0xd9, 0xb8,
+	0x03, 0xe0, 0x63, 0xc6, 0x2d, 0xa9, 0x56, 0xee, 0x8d, 0xd4, 0x2a, 0x26, 0x15, 0x0e, 0x93, 0x02,
+	0xe8, 0x0b, 0xd8, 0xb0, 0x71, 0xe8, 0x24, 0x92, 0x10, 0xeb, 0xff, 0x74, 0x60, 0x7d, 0x5e, 0x46,
+	0x16, 0xbe, 0x0c, 0x6b, 0x8c, 0xe3, 0x23, 0x9f, 0x48, 0x5d, 0x99, 0x18, 0xb8, 0xbc, 0x51, 0x96,
+	0xa0, 0xd0, 0x94, 0x35, 0x38, 0x6c, 0x75, 0x7c, 0x82, 0xe3, 0xb9, 0x10, 0xfb, 0x31, 0x8d, 0x28,
+	0xc3, 0x7e, 0xf2, 0xf2, 0xdc, 0xe3, 0xf3, 0x3b, 0x4f, 0x1a, 0x48, 0x83, 0x92, 0x43, 0x98, 0x1d,
+	0x7b, 0x11, 0x4f, 0x16, 0x5b, 0x5e, 0x53, 0xcb, 0x90, 0xb8, 0x71, 0x71, 0x20, 0xfa, 0x93, 0x4b,
+	0xf2, 0x84, 0x71, 0xb3, 0xfc, 0xe8, 0x89, 0x9a, 0xf9, 0xee, 0x89, 0x9a, 0xf9, 0xeb, 0x89, 0x9a,
+	0x69, 0xfc, 0xa0, 0xc0, 0xaa, 0x39, 0x19, 0x70, 0xcc, 0x47, 0x0c, 0x5d, 0x03, 0xf0, 0x42, 0x8b,
+	0x4f, 0x2c, 0xf1, 0xc1, 0x10, 0x4f, 0x6b, 0xaf, 0x9f, 0x4e, 0xd5, 0x25, 0xd4, 0x58, 0xf5, 0x42,
+	0x73, 0xb2, 0x9b, 0x7c, 0x44, 0x74, 0x28, 0xd1, 0x11, 0x9f, 0x87, 0x0b, 0x02, 0xed, 0x8d, 0xd3,
+	0xa9, 0xba, 0x0c, 0x1b, 0x45, 0x3a, 0xe2, 0x69, 0xc2, 0x2d, 0x58, 0x61, 0xe2, 0x41, 0xa2, 0x55,
+	0xeb, 0xdb, 0x17, 0x96, 0x6e, 0xe9, 0x19, 0x87, 0xe4, 0x93, 0xd5, 0x86, 0xd3, 0xa9, 0x9a, 0x86,
+	0x1a, 0xe9, 0xef, 0xd5, 0x9f, 0xb2, 0x50, 0x5e, 0x0e, 0x42, 0x1f, 0xc3, 0x5b, 0xe6, 0x3d, 0x6b,
+	0x60, 0xee, 0x98, 0x07, 0x03, 0x6b, 0xef, 0xae, 0x69, 0xdd, 0xbe, 0x7b, 0xb0, 0xd7, 0xad, 0x64,
+	0x6a, 0x17, 0x8e, 0x4f, 0xb4, 0x97, 0xb9, 0xd0, 0xa7, 0x50, 0x5b, 0xc0, 0xdd, 0xde, 0xfe, 0xdd,
+	0x41, 0xdf, 0xb4, 0x8c, 0x5e, 0xa7, 0xd7, 0x3f, 0xec, 0x75, 0x2b, 0x4a, 0xad, 0x7e, 0x7c, 0xa2,
+	0xbd, 0x22, 0x02, 0xdd, 0x80, 0x0b, 0x0b, 0x6f, 0x7b, 0xc7, 0xec, 0xec, 0x5a, 0x1d, 0xa3, 0xb7,
+	0x63, 0xf6, 0xba, 0x95, 0x6c, 0xed, 0xdd, 0xe3, 0x13, 0xed, 0x2c, 0x37, 0xba, 0x09, 0xd5, 0x7f,
+	0xbb, 0x7a, 0xf7, 0x7a, 0x9d, 0x83, 0x24, 0x35, 0x57, 0xbb, 0x78, 0x7c, 0xa2, 0x9d, 0xe9, 0x47,
+	0x2d, 0x40, 0x0b, 0x9f, 0xd1, 0xbb, 0x7d, 0xb0, 0xd7, 0xed, 0x75, 0x2b, 0xf9, 0xda, 0xd6, 0xf1,
+	0x89, 0xf6, 0x12, 0x4f, 0x2d, 0xff, 0xe8, 0xfb, 0x7a, 0xa6, 0xdd, 0x7f, 0xfa, 0xbc, 0xae, 0x3c,
+	0x7b, 0x5e, 0x57, 0xfe, 0x7c, 0x5e, 0x57, 0x1e, 0xbf, 0xa8, 0x67, 0x9e, 0xbd, 0xa8, 0x67, 0x7e,
+	0x7b, 0x51, 0xcf, 0x7c, 0xa9, 0x2f, 0x0d, 0xf1, 0x1d, 0xf1, 0xa5, 0x32, 0x09, 0x0e, 0xf4, 0xc0,
+	0x1d, 0x1d, 0xe9, 0xb6, 0x8b, 0xbd, 0x50, 0x9f, 0xe8, 0xe9, 0xbf, 0x18, 0x31, 0xd1, 0x47, 0x2b,
+	0xe2, 0xca, 0xfa, 0xe4, 0x9f, 0x01, 0x00, 0x75, 0xd4, 0x73, 0x9e, 0xfe, 0x08, 0x00, 0x00,
 }
 
 func (m *GenericClaim) Marshal() (dAtA []byte, err error) {
@@ -642,6 +1003,23 @@ func (m *Coin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxChangePerEpoch != nil {
+		{
+			size := m.MaxChangePerEpoch.Size()
+			i -= size
+			if _, err := m.MaxChangePerEpoch.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.PricePrecision != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PricePrecision))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.CustomCommission != nil {
 		{
 			size := m.CustomCommission.Size()
@@ -681,7 +1059,7 @@ func (m *Coin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *TxStatus) Marshal() (dAtA []byte, err error) {
+func (m *PriceUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -691,63 +1069,323 @@ func (m *TxStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TxStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceUpdate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TxStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+	if m.MissingQuorum {
+		i--
+		if m.MissingQuorum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTypes(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
 		i--
 		dAtA[i] = 0x18
 	}
-	if len(m.OutTxHash) > 0 {
-		i -= len(m.OutTxHash)
-		copy(dAtA[i:], m.OutTxHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutTxHash)))
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x10
 	}
-	if len(m.InTxHash) > 0 {
-		i -= len(m.InTxHash)
-		copy(dAtA[i:], m.InTxHash)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.InTxHash)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GenericClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovTypes(uint64(m.Epoch))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ClaimType != 0 {
-		n += 1 + sovTypes(uint64(m.ClaimType))
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Hash)
-	if l > 0 {
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConflictingVotes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ConflictingVotes))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissedVotes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedVotes))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStartEpoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStartEpoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StableEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StableEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.CandidateValue.Size()
+		i -= size
+		if _, err := m.CandidateValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LastValue.Size()
+		i -= size
+		if _, err := m.LastValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClearPriceHaltProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClearPriceHaltProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClearPriceHaltProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Names) > 0 {
+		for iNdEx := len(m.Names) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Names[iNdEx])
+			copy(dAtA[i:], m.Names[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Names[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OutTxHash) > 0 {
+		i -= len(m.OutTxHash)
+		copy(dAtA[i:], m.OutTxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.OutTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.InTxHash) > 0 {
+		i -= len(m.InTxHash)
+		copy(dAtA[i:], m.InTxHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.InTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenericClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.ClaimType != 0 {
+		n += 1 + sovTypes(uint64(m.ClaimType))
+	}
+	l = len(m.Hash)
+	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
 	l = len(m.EventClaimer)
@@ -831,6 +1469,124 @@ func (m *Coin) Size() (n int) {
 		l = m.CustomCommission.Size()
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.PricePrecision != 0 {
+		n += 1 + sovTypes(uint64(m.PricePrecision))
+	}
+	if m.MaxChangePerEpoch != nil {
+		l = m.MaxChangePerEpoch.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	if m.MissingQuorum {
+		n += 2
+	}
+	return n
+}
+
+func (m *PriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *VotingInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowStartEpoch != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStartEpoch))
+	}
+	if m.MissedVotes != 0 {
+		n += 1 + sovTypes(uint64(m.MissedVotes))
+	}
+	if m.ConflictingVotes != 0 {
+		n += 1 + sovTypes(uint64(m.ConflictingVotes))
+	}
+	return n
+}
+
+func (m *PriceHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	l = m.LastValue.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.CandidateValue.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.StableEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.StableEpochs))
+	}
+	return n
+}
+
+func (m *ClearPriceHaltProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Names) > 0 {
+		for _, s := range m.Names {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -941,31 +1697,836 @@ func (m *GenericClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventClaimer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EventClaimer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgPriceClaim{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Claim = &GenericClaim_PriceClaim{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Epoch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &Vote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Vote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Oracle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Claim == nil {
+				m.Claim = &MsgPriceClaim{}
+			}
+			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Coin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Coin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Coin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterId", wireType)
+			}
+			m.MinterId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinterId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDecimals", wireType)
+			}
+			m.EthDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EthDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CustomCommission", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.CustomCommission = &v
+			if err := m.CustomCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PricePrecision", wireType)
+			}
+			m.PricePrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PricePrecision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangePerEpoch", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxChangePerEpoch = &v
+			if err := m.MaxChangePerEpoch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingQuorum", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MissingQuorum = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
-			if m.Hash == nil {
-				m.Hash = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventClaimer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -975,29 +2536,30 @@ func (m *GenericClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EventClaimer = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceClaim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1007,26 +2569,25 @@ func (m *GenericClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgPriceClaim{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Claim = &GenericClaim_PriceClaim{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1052,7 +2613,7 @@ func (m *GenericClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Epoch) Unmarshal(dAtA []byte) error {
+func (m *VotingInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1075,17 +2636,17 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Epoch: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Epoch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1095,16 +2656,29 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartEpoch", wireType)
 			}
-			var msglen int
+			m.WindowStartEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1114,26 +2688,49 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.WindowStartEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTypes
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.MissedVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConflictingVotes", wireType)
 			}
-			m.Votes = append(m.Votes, &Vote{})
-			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ConflictingVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConflictingVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1158,7 +2755,7 @@ func (m *Epoch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vote) Unmarshal(dAtA []byte) error {
+func (m *PriceHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1181,15 +2778,15 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vote: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Oracle", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1217,13 +2814,32 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Oracle = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claim", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastValue", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1233,28 +2849,79 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Claim == nil {
-				m.Claim = &MsgPriceClaim{}
+			if err := m.LastValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Claim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandidateValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CandidateValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StableEpochs", wireType)
+			}
+			m.StableEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StableEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1279,7 +2946,7 @@ func (m *Vote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Coin) Unmarshal(dAtA []byte) error {
+func (m *ClearPriceHaltProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1302,15 +2969,15 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Coin: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClearPriceHaltProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Coin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClearPriceHaltProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1338,11 +3005,11 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1370,51 +3037,13 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddr = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinterId", wireType)
-			}
-			m.MinterId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinterId |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthDecimals", wireType)
-			}
-			m.EthDecimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EthDecimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CustomCommission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Names", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1424,26 +3053,23 @@ func (m *Coin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Dec
-			m.CustomCommission = &v
-			if err := m.CustomCommission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Names = append(m.Names, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,102 @@
+package peggy
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/MinterTeam/mhub/chain/x/peggy/keeper"
+	"github.com/MinterTeam/mhub/chain/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingTransfersQueries(t *testing.T) {
+	const (
+		tokenContract = "0x429881672B9AE42b8EbA0E26cD9C73711b891Ca5"
+		receiver      = "0x3c9289da00b02dC623d0D8D907619890301D26d4"
+		receiver2     = "0x7f0fc21d932f38ca9444f61703174569066cfa50"
+	)
+	var (
+		sender = sdk.AccAddress(make([]byte, sdk.AddrLen)).String()
+		other  = sdk.AccAddress(append(make([]byte, sdk.AddrLen-1), 1)).String()
+		// the length of the long hash does not fit a byte, it must not be mistaken for the short one
+		longHash = strings.Repeat("a", 257)
+	)
+
+	entry := func(id uint64, sender, receiver, txHash string) types.OutgoingTxEntry {
+		return types.OutgoingTxEntry{Id: id, Tx: types.OutgoingTx{
+			Sender:    sender,
+			DestAddr:  receiver,
+			Amount:    sdk.NewInt64Coin("hub", 10),
+			BridgeFee: sdk.NewInt64Coin("hub", 1),
+			TxHash:    txHash,
+		}}
+	}
+	transfer := func(id uint64) *types.OutgoingTransferTx {
+		return &types.OutgoingTransferTx{Id: id}
+	}
+
+	input := keeper.CreateTestEnv(t)
+	ctx, k := input.Context, input.PeggyKeeper
+	params := keeper.TestingPeggyParams
+	// the txs are all batched, the unbatched index and the accounting bootstrap need the oracle which is not set up
+	keeper.InitGenesis(ctx, k, types.GenesisState{
+		Params: &params,
+		OutgoingTxs: []types.OutgoingTxEntry{
+			entry(1, sender, receiver, "a"),
+			entry(2, sender, receiver2, longHash),
+			entry(3, other, receiver, "0xcc"),
+		},
+		Batches: []*types.OutgoingTxBatch{
+			{BatchNonce: 7, TokenContract: tokenContract, Transactions: []*types.OutgoingTransferTx{transfer(1), transfer(3)}},
+			{BatchNonce: 8, TokenContract: tokenContract, Transactions: []*types.OutgoingTransferTx{transfer(2)}},
+		},
+		VoucherAccounting: types.VoucherAccounting{Burned: sdk.NewCoins(sdk.NewInt64Coin("hub", 33))},
+	})
+	c := sdk.WrapSDKContext(ctx)
+
+	bySender, err := k.PendingTransfersBySender(c, &types.QueryPendingTransfersBySenderRequest{Sender: sender})
+	require.NoError(t, err)
+	require.Len(t, bySender.Transfers, 2)
+	assert.Equal(t, uint64(1), bySender.Transfers[0].Id)
+	assert.Equal(t, uint64(7), bySender.Transfers[0].BatchNonce)
+	assert.Equal(t, uint64(2), bySender.Transfers[1].Id)
+	assert.Equal(t, uint64(8), bySender.Transfers[1].BatchNonce)
+
+	// the receiver is matched case insensitively and the results are paginated
+	byReceiver, err := k.PendingTransfersByReceiver(c, &types.QueryPendingTransfersByReceiverRequest{
+		Receiver:   strings.ToUpper(receiver),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Transfers, 1)
+	assert.Equal(t, uint64(1), byReceiver.Transfers[0].Id)
+	assert.Equal(t, uint64(2), byReceiver.Pagination.Total)
+
+	byReceiver, err = k.PendingTransfersByReceiver(c, &types.QueryPendingTransfersByReceiverRequest{
+		Receiver:   receiver,
+		Pagination: &query.PageRequest{Key: byReceiver.Pagination.NextKey, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Len(t, byReceiver.Transfers, 1)
+	assert.Equal(t, uint64(3), byReceiver.Transfers[0].Id)
+	assert.Equal(t, other, byReceiver.Transfers[0].Tx.Sender)
+
+	for hash, expID := range map[string]uint64{"a": 1, longHash: 2} {
+		byHash, err := k.TransferByTxHash(c, &types.QueryTransferByTxHashRequest{TxHash: hash})
+		require.NoError(t, err)
+		require.Len(t, byHash.Transfers, 1)
+		assert.Equal(t, expID, byHash.Transfers[0].Id)
+	}
+
+	// the migration of a pool which is indexed already changes nothing
+	k.MigratePoolIndexes(ctx)
+	bySender, err = k.PendingTransfersBySender(c, &types.QueryPendingTransfersBySenderRequest{Sender: sender})
+	require.NoError(t, err)
+	assert.Len(t, bySender.Transfers, 2)
+
+	_, err = k.PendingTransfersBySender(c, &types.QueryPendingTransfersBySenderRequest{})
+	assert.Error(t, err)
+}
@@ -36,8 +36,8 @@ func (a AttestationHandler) Handle(ctx sdk.Context, att types.Attestation, claim
 		}
 
 		vouchers := sdk.Coins{coin}
-		if err := a.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
-			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers)
+		if err := a.keeper.mintVouchers(ctx, vouchers); err != nil {
+			return err
 		}
 
 		addr, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
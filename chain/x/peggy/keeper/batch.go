@@ -14,7 +14,6 @@ import (
 )
 
 const OutgoingTxBatchSize = 100
-const gweiInEth = 1e9
 
 // BuildOutgoingTXBatch starts the following process chain:
 // - find bridged denominator for given voucher type
@@ -46,18 +45,12 @@ func (k Keeper) BuildOutgoingTXBatch(ctx sdk.Context, contractAddress string, ma
 		return nil, sdkerrors.Wrap(err, "coin price")
 	}
 
-	gasPrice, err := k.oracleKeeper.GetEthGasPrice(ctx)
+	totalUsdGas, err := k.oracleKeeper.EstimateWithdrawCostUSD(ctx, k.oracleKeeper.GetMinBatchGas(ctx))
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "gas price")
+		return nil, sdkerrors.Wrap(err, "gas cost")
 	}
 
-	ethPrice, err := k.oracleKeeper.GetEthPrice(ctx)
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "eth price")
-	}
-
-	totalUsdCommission := totalCommission.Mul(price).Quo(k.oracleKeeper.GetPipInBip())
-	totalUsdGas := gasPrice.Mul(ethPrice).MulRaw(int64(k.oracleKeeper.GetMinBatchGas(ctx))).QuoRaw(gweiInEth).QuoRaw(k.oracleKeeper.GetGasUnits())
+	totalUsdCommission := price.MulInt(totalCommission).QuoInt(k.oracleKeeper.GetPipInBip())
 	if totalUsdCommission.LT(totalUsdGas) {
 		return nil, sdkerrors.Wrap(types.ErrInvalid, "not enough gas yet")
 	}
@@ -106,14 +99,17 @@ func (k Keeper) OutgoingTxBatchExecuted(ctx sdk.Context, tokenContract string, n
 	// cleanup outgoing TX pool
 	for _, tx := range b.Transactions {
 		totalFee = totalFee.Add(tx.Erc20Fee.PeggyCoin(ctx, k.oracleKeeper))
+		if entry, err := k.getPoolEntry(ctx, tx.Id); err == nil {
+			k.releaseVouchers(ctx, k.outgoingVouchers(ctx, entry))
+		}
 		k.removePoolEntry(ctx, tx.Id)
 	}
 
 	if totalFee.IsPositive() {
 		commissionKeeperAddress := sdk.AccAddress{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 		vouchers := sdk.Coins{totalFee}
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
-			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers)
+		if err := k.mintVouchers(ctx, vouchers); err != nil {
+			return err
 		}
 
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, commissionKeeperAddress, vouchers); err != nil {
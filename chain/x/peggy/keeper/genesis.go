@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"github.com/MinterTeam/mhub/chain/x/peggy/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -35,6 +37,38 @@ func InitGenesis(ctx sdk.Context, k Keeper, data types.GenesisState) {
 		// TODO: block height?
 		k.SetAttestationUnsafe(ctx, &att)
 	}
+
+	// reset the outgoing pool in state, the txs which are not in a batch go back to the unbatched index
+	batched := map[uint64]bool{}
+	for _, batch := range data.Batches {
+		for _, tx := range batch.Transactions {
+			batched[tx.Id] = true
+		}
+	}
+	for _, entry := range data.OutgoingTxs {
+		tx := entry.Tx
+		if err := k.setPoolEntry(ctx, entry.Id, &tx); err != nil {
+			panic(err)
+		}
+		if !batched[entry.Id] {
+			k.appendToUnbatchedTXIndex(ctx, tx.BridgeFee, entry.Id)
+		}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if data.StartTxPoolId != 0 {
+		store.Set(types.KeyLastTXPoolID, sdk.Uint64ToBigEndian(data.StartTxPoolId))
+	}
+	if data.StartBatchNonce != 0 {
+		store.Set(types.KeyLastOutgoingBatchID, sdk.Uint64ToBigEndian(data.StartBatchNonce))
+	}
+
+	// the genesis exported before the vouchers were accounted has no accounting, it is bootstrapped from the pool
+	if accounting := data.VoucherAccounting; accounting.Minted.Empty() && accounting.Burned.Empty() && accounting.Released.Empty() {
+		k.bootstrapVoucherAccounting(ctx)
+	} else {
+		k.setVoucherAccounting(ctx, accounting)
+	}
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -48,6 +82,8 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		vsconfs      = []*types.MsgValsetConfirm{}
 		batchconfs   = []types.MsgConfirmBatch{}
 		attestations = []types.Attestation{}
+		outgoingTxs  = []types.OutgoingTxEntry{}
+		store        = ctx.KVStore(k.storeKey)
 	)
 
 	// export valset confirmations from state
@@ -68,12 +104,31 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		attestations = append(attestations, atts...)
 	}
 
+	// export the outgoing pool from state
+	k.iteratePoolEntries(ctx, func(id uint64, tx types.OutgoingTx) {
+		outgoingTxs = append(outgoingTxs, types.OutgoingTxEntry{Id: id, Tx: tx})
+	})
+
 	return types.GenesisState{
-		Params:         &p,
-		Valsets:        valsets,
-		ValsetConfirms: vsconfs,
-		Batches:        batches,
-		BatchConfirms:  batchconfs,
-		Attestations:   attestations,
+		Params:            &p,
+		Valsets:           valsets,
+		ValsetConfirms:    vsconfs,
+		Batches:           batches,
+		BatchConfirms:     batchconfs,
+		Attestations:      attestations,
+		OutgoingTxs:       outgoingTxs,
+		StartTxPoolId:     getSequence(store, types.KeyLastTXPoolID),
+		StartBatchNonce:   getSequence(store, types.KeyLastOutgoingBatchID),
+		VoucherAccounting: k.GetVoucherAccounting(ctx),
 	}
 }
+
+// getSequence returns the next value of the sequence
+func getSequence(store sdk.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 1
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
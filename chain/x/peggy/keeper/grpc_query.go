@@ -2,10 +2,13 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
 	"github.com/MinterTeam/mhub/chain/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -151,3 +154,98 @@ func (k Keeper) LastEventNonceByAddr(c context.Context, req *types.QueryLastEven
 	ret.EventNonce = lastEventNonce
 	return &ret, nil
 }
+
+// PendingTransfersBySender returns the transfers of the outgoing pool sent by the given address
+func (k Keeper) PendingTransfersBySender(c context.Context, req *types.QueryPendingTransfersBySenderRequest) (*types.QueryPendingTransfersBySenderResponse, error) {
+	if req.Sender == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty sender")
+	}
+
+	transfers, pageRes, err := k.pendingTransfers(sdk.UnwrapSDKContext(c), types.OutgoingTXSenderIndexKey, req.Sender, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingTransfersBySenderResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// PendingTransfersByReceiver returns the transfers of the outgoing pool sent to the given address
+func (k Keeper) PendingTransfersByReceiver(c context.Context, req *types.QueryPendingTransfersByReceiverRequest) (*types.QueryPendingTransfersByReceiverResponse, error) {
+	if req.Receiver == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty receiver")
+	}
+
+	transfers, pageRes, err := k.pendingTransfers(sdk.UnwrapSDKContext(c), types.OutgoingTXReceiverIndexKey, req.Receiver, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPendingTransfersByReceiverResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// TransferByTxHash returns the transfers of the outgoing pool created by the inbound tx with the given hash
+func (k Keeper) TransferByTxHash(c context.Context, req *types.QueryTransferByTxHashRequest) (*types.QueryTransferByTxHashResponse, error) {
+	if req.TxHash == "" {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty tx hash")
+	}
+
+	transfers, pageRes, err := k.pendingTransfers(sdk.UnwrapSDKContext(c), types.OutgoingTXHashIndexKey, req.TxHash, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTransferByTxHashResponse{Transfers: transfers, Pagination: pageRes}, nil
+}
+
+// pendingTransfers paginates over the pool entries indexed under the value together with the nonces of their batches
+func (k Keeper) pendingTransfers(ctx sdk.Context, indexKey []byte, value string, pagination *query.PageRequest) ([]types.PendingTransfer, *query.PageResponse, error) {
+	var transfers []types.PendingTransfer
+	indexStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetOutgoingTxIndexPrefix(indexKey, value))
+	pageRes, err := query.Paginate(indexStore, pagination, func(key []byte, _ []byte) error {
+		id := binary.BigEndian.Uint64(key)
+		tx, err := k.getPoolEntry(ctx, id)
+		if err != nil {
+			return sdkerrors.Wrapf(err, "pool entry %d", id)
+		}
+
+		transfers = append(transfers, types.PendingTransfer{
+			Id: id,
+			Tx: *tx,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	k.setPendingBatchNonces(ctx, transfers)
+	return transfers, pageRes, nil
+}
+
+// setPendingBatchNonces sets the nonces of the batches which include the transfers. The batches are loaded only for
+// the batched transfers and only until all of them are found.
+func (k Keeper) setPendingBatchNonces(ctx sdk.Context, transfers []types.PendingTransfer) {
+	batched := make(map[uint64]int, len(transfers))
+	for i, transfer := range transfers {
+		batched[transfer.Id] = i
+	}
+
+	for _, id := range k.getUnbatchedTxIDs(ctx) {
+		delete(batched, id)
+	}
+
+	if len(batched) == 0 {
+		return
+	}
+
+	k.IterateOutgoingTXBatches(ctx, func(_ []byte, batch *types.OutgoingTxBatch) bool {
+		for _, tx := range batch.Transactions {
+			if i, ok := batched[tx.Id]; ok {
+				transfers[i].BatchNonce = batch.BatchNonce
+				delete(batched, tx.Id)
+			}
+		}
+
+		return len(batched) == 0
+	})
+}
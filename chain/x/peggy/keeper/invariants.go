@@ -0,0 +1,209 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/MinterTeam/mhub/chain/x/peggy/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers the peggy module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "voucher-supply", VoucherSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "outgoing-pool", OutgoingPoolInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "unbatched-index", UnbatchedIndexInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "batched-txs", BatchedTxsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the peggy module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		if res, stop := VoucherSupplyInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		if res, stop := OutgoingPoolInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		if res, stop := UnbatchedIndexInvariant(k)(ctx); stop {
+			return res, stop
+		}
+
+		return BatchedTxsInvariant(k)(ctx)
+	}
+}
+
+// VoucherSupplyInvariant checks that the supply of every bridged denom equals the vouchers minted minus the vouchers
+// burned by both the minter and the peggy modules. The staking denom is skipped, it is minted by the chain as well.
+func VoucherSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			count  int
+			supply = k.bankKeeper.GetSupply(ctx).GetTotal()
+		)
+
+		minter := k.minterKeeper.GetVoucherAccounting(ctx)
+		peggy := k.GetVoucherAccounting(ctx)
+		minted := minter.Minted.Add(peggy.Minted...)
+		burned := minter.Burned.Add(peggy.Burned...)
+
+		bondDenom := k.StakingKeeper.BondDenom(ctx)
+		for _, coin := range k.oracleKeeper.GetCoins(ctx).List() {
+			if coin.Denom == bondDenom {
+				continue
+			}
+
+			expected := minted.AmountOf(coin.Denom).Sub(burned.AmountOf(coin.Denom))
+			if actual := supply.AmountOf(coin.Denom); !actual.Equal(expected) {
+				count++
+				msg += fmt.Sprintf("\t%s supply is %s, minted %s, burned %s\n", coin.Denom, actual, minted.AmountOf(coin.Denom), burned.AmountOf(coin.Denom))
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "voucher-supply",
+			fmt.Sprintf("amount of mismatched vouchers found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// OutgoingPoolInvariant checks that the outgoing pool holds exactly the burned vouchers which are not released yet and
+// that every tx of the pool is either unbatched or batched
+func OutgoingPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg     string
+			broken  bool
+			pending = sdk.Coins{}
+			indexed = map[uint64]bool{}
+		)
+
+		for _, id := range k.getUnbatchedTxIDs(ctx) {
+			indexed[id] = true
+		}
+
+		for _, batch := range k.GetOutgoingTxBatches(ctx) {
+			for _, tx := range batch.Transactions {
+				indexed[tx.Id] = true
+			}
+		}
+
+		k.iteratePoolEntries(ctx, func(id uint64, tx types.OutgoingTx) {
+			pending = pending.Add(k.outgoingVouchers(ctx, &tx)...)
+
+			if !indexed[id] {
+				broken = true
+				msg += fmt.Sprintf("\ttx %d is neither unbatched nor batched\n", id)
+			}
+		})
+
+		accounting := k.GetVoucherAccounting(ctx)
+		expected, negative := accounting.Burned.SafeSub(accounting.Released)
+		if negative || !expected.IsEqual(pending) {
+			broken = true
+			msg += fmt.Sprintf("\tburned: %s\n\treleased: %s\n\tpending in pool: %s\n", accounting.Burned, accounting.Released, pending)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "outgoing-pool", msg), broken
+	}
+}
+
+// UnbatchedIndexInvariant checks that every id of the unbatched index points to an existing pool entry
+func UnbatchedIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+			seen  = map[uint64]bool{}
+		)
+
+		for _, id := range k.getUnbatchedTxIDs(ctx) {
+			if seen[id] {
+				count++
+				msg += fmt.Sprintf("\ttx %d is indexed more than once\n", id)
+			}
+			seen[id] = true
+
+			if _, err := k.getPoolEntry(ctx, id); err != nil {
+				count++
+				msg += fmt.Sprintf("\ttx %d is not in the pool\n", id)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "unbatched-index",
+			fmt.Sprintf("amount of broken unbatched ids found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// BatchedTxsInvariant checks that no tx is included in more than one batch or is both batched and unbatched, and that
+// every batched tx points to an existing pool entry
+func BatchedTxsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg       string
+			count     int
+			batchedIn = map[uint64]uint64{}
+			unbatched = map[uint64]bool{}
+		)
+
+		for _, id := range k.getUnbatchedTxIDs(ctx) {
+			unbatched[id] = true
+		}
+
+		for _, batch := range k.GetOutgoingTxBatches(ctx) {
+			for _, tx := range batch.Transactions {
+				if nonce, ok := batchedIn[tx.Id]; ok {
+					count++
+					msg += fmt.Sprintf("\ttx %d is in batches %d and %d\n", tx.Id, nonce, batch.BatchNonce)
+				}
+				batchedIn[tx.Id] = batch.BatchNonce
+
+				if unbatched[tx.Id] {
+					count++
+					msg += fmt.Sprintf("\ttx %d of batch %d is unbatched too\n", tx.Id, batch.BatchNonce)
+				}
+
+				if _, err := k.getPoolEntry(ctx, tx.Id); err != nil {
+					count++
+					msg += fmt.Sprintf("\ttx %d of batch %d is not in the pool\n", tx.Id, batch.BatchNonce)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "batched-txs",
+			fmt.Sprintf("amount of broken batched txs found %d\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// getUnbatchedTxIDs returns the ids of the unbatched index of all the token contracts
+func (k Keeper) getUnbatchedTxIDs(ctx sdk.Context) (ids []uint64) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var idSet types.IDSet
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &idSet)
+		ids = append(ids, idSet.Ids...)
+	}
+
+	return ids
+}
+
+func (k Keeper) iteratePoolEntries(ctx sdk.Context, cb func(id uint64, tx types.OutgoingTx)) {
+	iter := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingTXPoolKey).Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var tx types.OutgoingTx
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &tx)
+		cb(binary.BigEndian.Uint64(iter.Key()), tx)
+	}
+}
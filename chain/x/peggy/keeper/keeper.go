@@ -415,13 +415,11 @@ func (k Keeper) RefundOutgoingTx(ctx sdk.Context, id uint64, tx *types.OutgoingT
 	}
 	k.removePoolEntry(ctx, id)
 
-	contractAddr, _ := types.ValidatePeggyCoin(tx.Amount, ctx, k.oracleKeeper)
+	vouchers := k.outgoingVouchers(ctx, tx)
+	k.releaseVouchers(ctx, vouchers)
 
-	vouchers := sdk.NewCoins(sdk.NewCoin(tx.Amount.Denom, k.oracleKeeper.ConvertFromEthValue(ctx, contractAddr, tx.Amount.Amount)))
-	vouchers = vouchers.Add(tx.BridgeFee)
-
-	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
-		panic(sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers))
+	if err := k.mintVouchers(ctx, vouchers); err != nil {
+		panic(err)
 	}
 
 	if tx.RefundAddr[:2] == "Mx" {
@@ -462,8 +460,8 @@ func (k Keeper) ColdStorageTransfer(ctx sdk.Context, c *types.ColdStorageTransfe
 		}
 
 		vouchers := sdk.Coins{coin}
-		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, vouchers); err != nil {
-			return sdkerrors.Wrapf(err, "mint vouchers coins: %s", vouchers)
+		if err := k.mintVouchers(ctx, vouchers); err != nil {
+			return err
 		}
 
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, defaultSender, vouchers); err != nil {
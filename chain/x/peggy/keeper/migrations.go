@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"github.com/MinterTeam/mhub/chain/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MigrateVoucherAccounting bootstraps the voucher accounting of a chain which has run before the vouchers were accounted
+func (k Keeper) MigrateVoucherAccounting(ctx sdk.Context) {
+	if !ctx.KVStore(k.storeKey).Has(types.VoucherAccountingKey) {
+		k.bootstrapVoucherAccounting(ctx)
+	}
+}
+
+// MigratePoolIndexes indexes the outgoing txs which were added to the pool before the sender, receiver and tx hash
+// indexes existed
+func (k Keeper) MigratePoolIndexes(ctx sdk.Context) {
+	var keys [][]byte
+	k.iteratePoolEntries(ctx, func(id uint64, tx types.OutgoingTx) {
+		keys = append(keys, poolIndexKeys(id, &tx)...)
+	})
+
+	store := ctx.KVStore(k.storeKey)
+	for _, key := range keys {
+		store.Set(key, []byte{0x1})
+	}
+}
@@ -145,18 +145,12 @@ func (k msgServer) SendToEth(c context.Context, msg *types.MsgSendToEth) (*types
 		return nil, sdkerrors.Wrap(err, "fee")
 	}
 
-	gasPrice, err := k.oracleKeeper.GetEthGasPrice(ctx)
+	totalUsdGas, err := k.oracleKeeper.EstimateWithdrawCostUSD(ctx, k.oracleKeeper.GetMinSingleWithdrawGas(ctx))
 	if err != nil {
-		return nil, sdkerrors.Wrap(err, "gas price")
+		return nil, sdkerrors.Wrap(err, "gas cost")
 	}
 
-	ethPrice, err := k.oracleKeeper.GetEthPrice(ctx)
-	if err != nil {
-		return nil, sdkerrors.Wrap(err, "eth price")
-	}
-
-	totalUsdCommission := msg.BridgeFee.Amount.Mul(coinPrice).Quo(k.oracleKeeper.GetPipInBip())
-	totalUsdGas := gasPrice.Mul(ethPrice).MulRaw(int64(k.oracleKeeper.GetMinSingleWithdrawGas(ctx))).QuoRaw(gweiInEth).QuoRaw(k.oracleKeeper.GetGasUnits())
+	totalUsdCommission := coinPrice.MulInt(msg.BridgeFee.Amount).QuoInt(k.oracleKeeper.GetPipInBip())
 	if totalUsdCommission.LT(totalUsdGas) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "bridge fee is not sufficient")
 	}
@@ -33,7 +33,7 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 	}
 
 	// burn vouchers to send them back to ETH
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, totalInVouchers); err != nil {
+	if err := k.burnVouchers(ctx, totalInVouchers); err != nil {
 		panic(err)
 	}
 
@@ -56,12 +56,14 @@ func (k Keeper) AddToOutgoingPool(ctx sdk.Context, sender sdk.AccAddress, counte
 		return 0, err
 	}
 
+	// the part of the amount lost in the conversion to the Ethereum decimals is never transferred
+	if dust, negative := totalInVouchers.SafeSub(k.outgoingVouchers(ctx, outgoing)); !negative && !dust.IsZero() {
+		k.releaseVouchers(ctx, dust)
+	}
+
 	// add a second index with the fee
 	k.appendToUnbatchedTXIndex(ctx, fee, nextID)
 
-	// todo: add second index for sender so that we can easily query: give pending Tx by sender
-	// todo: what about a second index for receiver?
-
 	poolEvent := sdk.NewEvent(
 		types.EventTypeBridgeWithdrawalReceived,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
@@ -133,6 +135,9 @@ func (k Keeper) setPoolEntry(ctx sdk.Context, id uint64, val *types.OutgoingTx)
 	}
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.GetOutgoingTxPoolKey(id), bz)
+	for _, key := range poolIndexKeys(id, val) {
+		store.Set(key, []byte{0x1})
+	}
 	return nil
 }
 
@@ -149,9 +154,27 @@ func (k Keeper) getPoolEntry(ctx sdk.Context, id uint64) (*types.OutgoingTx, err
 
 func (k Keeper) removePoolEntry(ctx sdk.Context, id uint64) {
 	store := ctx.KVStore(k.storeKey)
+	if tx, err := k.getPoolEntry(ctx, id); err == nil {
+		for _, key := range poolIndexKeys(id, tx) {
+			store.Delete(key)
+		}
+	}
 	store.Delete(types.GetOutgoingTxPoolKey(id))
 }
 
+// poolIndexKeys returns the keys of the outgoing tx in the sender, receiver and inbound tx hash indexes
+func poolIndexKeys(id uint64, tx *types.OutgoingTx) [][]byte {
+	keys := [][]byte{
+		types.GetOutgoingTxIndexKey(types.OutgoingTXSenderIndexKey, tx.Sender, id),
+		types.GetOutgoingTxIndexKey(types.OutgoingTXReceiverIndexKey, tx.DestAddr, id),
+	}
+	if tx.TxHash != "" {
+		keys = append(keys, types.GetOutgoingTxIndexKey(types.OutgoingTXHashIndexKey, tx.TxHash, id))
+	}
+
+	return keys
+}
+
 // IterateOutgoingPoolByFee itetates over the outgoing pool which is sorted by fee
 func (k Keeper) IterateOutgoingPoolByFee(ctx sdk.Context, contract string, cb func(uint64, *types.OutgoingTx) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.SecondIndexOutgoingTXFeeKey)
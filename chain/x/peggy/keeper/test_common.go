@@ -568,6 +568,11 @@ func (s *StakingKeeperMock) Slash(sdk.Context, sdk.ConsAddress, int64, int64, sd
 // Jail staisfies the interface
 func (s *StakingKeeperMock) Jail(sdk.Context, sdk.ConsAddress) {}
 
+// BondDenom staisfies the interface
+func (s *StakingKeeperMock) BondDenom(sdk.Context) string {
+	return TestingStakeParams.BondDenom
+}
+
 // AlwaysPanicStakingMock is a mock staking keeper that panics on usage
 type AlwaysPanicStakingMock struct{}
 
@@ -152,12 +152,16 @@ func (m *Params) GetStopped() bool {
 
 // GenesisState struct
 type GenesisState struct {
-	Params         *Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Valsets        []*Valset           `protobuf:"bytes,2,rep,name=valsets,proto3" json:"valsets,omitempty"`
-	ValsetConfirms []*MsgValsetConfirm `protobuf:"bytes,3,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
-	Batches        []*OutgoingTxBatch  `protobuf:"bytes,4,rep,name=batches,proto3" json:"batches,omitempty"`
-	BatchConfirms  []MsgConfirmBatch   `protobuf:"bytes,5,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
-	Attestations   []Attestation       `protobuf:"bytes,6,rep,name=attestations,proto3" json:"attestations"`
+	Params            *Params             `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Valsets           []*Valset           `protobuf:"bytes,2,rep,name=valsets,proto3" json:"valsets,omitempty"`
+	ValsetConfirms    []*MsgValsetConfirm `protobuf:"bytes,3,rep,name=valset_confirms,json=valsetConfirms,proto3" json:"valset_confirms,omitempty"`
+	Batches           []*OutgoingTxBatch  `protobuf:"bytes,4,rep,name=batches,proto3" json:"batches,omitempty"`
+	BatchConfirms     []MsgConfirmBatch   `protobuf:"bytes,5,rep,name=batch_confirms,json=batchConfirms,proto3" json:"batch_confirms"`
+	Attestations      []Attestation       `protobuf:"bytes,6,rep,name=attestations,proto3" json:"attestations"`
+	OutgoingTxs       []OutgoingTxEntry   `protobuf:"bytes,7,rep,name=outgoing_txs,json=outgoingTxs,proto3" json:"outgoing_txs"`
+	StartTxPoolId     uint64              `protobuf:"varint,8,opt,name=start_tx_pool_id,json=startTxPoolId,proto3" json:"start_tx_pool_id,omitempty"`
+	StartBatchNonce   uint64              `protobuf:"varint,9,opt,name=start_batch_nonce,json=startBatchNonce,proto3" json:"start_batch_nonce,omitempty"`
+	VoucherAccounting VoucherAccounting   `protobuf:"bytes,10,opt,name=voucher_accounting,json=voucherAccounting,proto3" json:"voucher_accounting"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -235,58 +239,148 @@ func (m *GenesisState) GetAttestations() []Attestation {
 	return nil
 }
 
+func (m *GenesisState) GetOutgoingTxs() []OutgoingTxEntry {
+	if m != nil {
+		return m.OutgoingTxs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStartTxPoolId() uint64 {
+	if m != nil {
+		return m.StartTxPoolId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStartBatchNonce() uint64 {
+	if m != nil {
+		return m.StartBatchNonce
+	}
+	return 0
+}
+
+func (m *GenesisState) GetVoucherAccounting() VoucherAccounting {
+	if m != nil {
+		return m.VoucherAccounting
+	}
+	return VoucherAccounting{}
+}
+
+// OutgoingTxEntry is a transfer in the outgoing pool under its id
+type OutgoingTxEntry struct {
+	Id uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tx OutgoingTx `protobuf:"bytes,2,opt,name=tx,proto3" json:"tx"`
+}
+
+func (m *OutgoingTxEntry) Reset()         { *m = OutgoingTxEntry{} }
+func (m *OutgoingTxEntry) String() string { return proto.CompactTextString(m) }
+func (*OutgoingTxEntry) ProtoMessage()    {}
+func (*OutgoingTxEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84231c3b3f050761, []int{2}
+}
+func (m *OutgoingTxEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingTxEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingTxEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingTxEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingTxEntry.Merge(m, src)
+}
+func (m *OutgoingTxEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingTxEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingTxEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingTxEntry proto.InternalMessageInfo
+
+func (m *OutgoingTxEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *OutgoingTxEntry) GetTx() OutgoingTx {
+	if m != nil {
+		return m.Tx
+	}
+	return OutgoingTx{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "peggy.v1.Params")
 	proto.RegisterType((*GenesisState)(nil), "peggy.v1.GenesisState")
+	proto.RegisterType((*OutgoingTxEntry)(nil), "peggy.v1.OutgoingTxEntry")
 }
 
 func init() { proto.RegisterFile("peggy/v1/genesis.proto", fileDescriptor_84231c3b3f050761) }
 
 var fileDescriptor_84231c3b3f050761 = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0x1b, 0x3b,
-	0x14, 0xce, 0x40, 0x48, 0xc0, 0x04, 0x82, 0x4c, 0xb8, 0x32, 0x91, 0x6e, 0x88, 0x58, 0x70, 0x73,
-	0xaf, 0x74, 0x67, 0xf8, 0xd9, 0x75, 0x53, 0x91, 0x54, 0x14, 0x16, 0xa8, 0xd5, 0x80, 0x5a, 0xa9,
-	0x9b, 0xa9, 0x33, 0x63, 0x3c, 0x56, 0x33, 0xe3, 0x68, 0xec, 0x04, 0xd8, 0xf5, 0x11, 0xfa, 0x20,
-	0x7d, 0x10, 0x96, 0x2c, 0xab, 0xaa, 0x42, 0x15, 0x2c, 0xfa, 0x1a, 0x55, 0x8e, 0x3d, 0xf9, 0xa1,
-	0x5d, 0xa1, 0xae, 0x66, 0xfc, 0x7d, 0xe7, 0x3b, 0x9f, 0x8f, 0x8f, 0x8f, 0xd1, 0x5f, 0x7d, 0xc6,
-	0xf9, 0xb5, 0x37, 0xdc, 0xf3, 0x38, 0x4b, 0x99, 0x12, 0xca, 0xed, 0x67, 0x52, 0x4b, 0xbc, 0x08,
-	0xb8, 0x3b, 0xdc, 0xab, 0xd7, 0xb8, 0xe4, 0x12, 0x40, 0x6f, 0xf4, 0x67, 0xf8, 0x7a, 0x6d, 0xac,
-	0xd3, 0xd7, 0x7d, 0x66, 0x55, 0xf5, 0xf5, 0x31, 0x9a, 0x28, 0xae, 0x7e, 0x09, 0xed, 0x52, 0x1d,
-	0xc6, 0x16, 0xad, 0x8f, 0x51, 0xaa, 0x35, 0x53, 0x9a, 0x6a, 0x21, 0x53, 0xc3, 0x6d, 0x7f, 0x2e,
-	0xa1, 0xd2, 0x6b, 0x9a, 0xd1, 0x44, 0xe1, 0x4d, 0x64, 0x76, 0x12, 0x88, 0x88, 0x38, 0x4d, 0xa7,
-	0xb5, 0xe4, 0x97, 0x61, 0x7d, 0x12, 0xe1, 0x5d, 0x54, 0x0b, 0x65, 0xaa, 0x33, 0x1a, 0xea, 0x40,
-	0xc9, 0x41, 0x16, 0xb2, 0x20, 0xa6, 0x2a, 0x26, 0x73, 0x10, 0x86, 0x73, 0xee, 0x0c, 0xa8, 0x63,
-	0xaa, 0x62, 0xfc, 0x0f, 0xaa, 0x2a, 0x4d, 0x33, 0x1d, 0xe8, 0x38, 0x63, 0x2a, 0x96, 0xbd, 0x88,
-	0xcc, 0x37, 0x9d, 0x56, 0xd1, 0x5f, 0x05, 0xf8, 0x3c, 0x47, 0xf1, 0xbf, 0x68, 0x8d, 0xe9, 0x98,
-	0x65, 0x6c, 0x90, 0x04, 0x34, 0x8a, 0x32, 0xa6, 0x14, 0x29, 0x42, 0xda, 0x6a, 0x8e, 0x1f, 0x1a,
-	0x18, 0xef, 0xa0, 0x6a, 0x37, 0x13, 0x11, 0x67, 0x41, 0x18, 0x53, 0x91, 0x8e, 0xf6, 0xb9, 0x00,
-	0x39, 0x57, 0x0c, 0xdc, 0x19, 0xa1, 0x27, 0x11, 0xde, 0x47, 0x1b, 0x4a, 0xf0, 0x94, 0x45, 0xc1,
-	0x90, 0xf6, 0x14, 0xd3, 0x2a, 0xb8, 0x14, 0x69, 0x24, 0x2f, 0x49, 0x09, 0xa2, 0xd7, 0x0d, 0xf9,
-	0xc6, 0x70, 0x6f, 0x81, 0x9a, 0xd2, 0xc0, 0xc9, 0xb1, 0xb1, 0xa6, 0x3c, 0xad, 0x69, 0x1b, 0xce,
-	0x6a, 0x76, 0x51, 0xcd, 0x6a, 0xc2, 0x1e, 0x15, 0xc9, 0x58, 0xb2, 0x08, 0x12, 0x6c, 0xb8, 0x0e,
-	0x50, 0x56, 0xd1, 0x45, 0x1b, 0xaa, 0x47, 0x55, 0x1c, 0x5c, 0x8c, 0x8e, 0x4b, 0xc8, 0xd4, 0xee,
-	0x90, 0x2c, 0x35, 0x9d, 0x56, 0xa5, 0xed, 0xde, 0xdc, 0x6d, 0x15, 0xbe, 0xde, 0x6d, 0xed, 0x70,
-	0xa1, 0xe3, 0x41, 0xd7, 0x0d, 0x65, 0xe2, 0x85, 0x52, 0x25, 0x52, 0xd9, 0xcf, 0xff, 0x2a, 0xfa,
-	0x60, 0x6f, 0xc1, 0x0b, 0x16, 0xfa, 0xeb, 0x90, 0xec, 0xc8, 0xe6, 0x32, 0x05, 0xe1, 0xf7, 0xa8,
-	0xf6, 0xc8, 0x03, 0x2a, 0x22, 0xe8, 0x49, 0x16, 0x78, 0xc6, 0x02, 0xea, 0xff, 0x8d, 0x03, 0xd4,
-	0x4f, 0x96, 0xff, 0x80, 0x03, 0x1c, 0x17, 0xbe, 0x44, 0xcd, 0xc7, 0x0e, 0x32, 0xbd, 0xe8, 0x89,
-	0x50, 0x8b, 0x94, 0x5b, 0xb7, 0xca, 0x93, 0xdc, 0xfe, 0x9e, 0x75, 0x9b, 0x64, 0x35, 0xc6, 0x04,
-	0x95, 0x95, 0x96, 0xfd, 0x3e, 0x8b, 0xc8, 0x4a, 0xd3, 0x69, 0x2d, 0xfa, 0xf9, 0xf2, 0x59, 0xf1,
-	0xe3, 0xb7, 0x66, 0x61, 0xfb, 0xc7, 0x1c, 0xaa, 0xbc, 0x34, 0xd3, 0x7b, 0xa6, 0xa9, 0x66, 0xb8,
-	0x85, 0x4a, 0x7d, 0x18, 0x1f, 0x18, 0x99, 0xe5, 0xfd, 0x35, 0x37, 0x9f, 0x66, 0xd7, 0x8c, 0x95,
-	0x6f, 0x79, 0xfc, 0x1f, 0x2a, 0xdb, 0xeb, 0x48, 0xe6, 0x9a, 0xf3, 0xb3, 0xa1, 0xa6, 0x75, 0x7e,
-	0x1e, 0x80, 0x3b, 0xa8, 0x6a, 0x7e, 0xa1, 0x6e, 0x91, 0x25, 0x8a, 0xcc, 0x83, 0xa6, 0x3e, 0xd1,
-	0x9c, 0x2a, 0x6e, 0x64, 0x1d, 0x13, 0xe2, 0xaf, 0x0e, 0xa7, 0x97, 0x0a, 0x1f, 0xa0, 0xb2, 0xbd,
-	0xcb, 0xa4, 0x08, 0xe2, 0xcd, 0x89, 0xf8, 0xd5, 0x40, 0x73, 0x29, 0x52, 0x7e, 0x7e, 0x05, 0x2d,
-	0xf5, 0xf3, 0x48, 0x7c, 0x84, 0x56, 0xe1, 0x77, 0x62, 0xbc, 0xf0, 0x58, 0x7b, 0xaa, 0xb8, 0xf5,
-	0x00, 0x6d, 0xbb, 0x38, 0x6a, 0x81, 0xbf, 0x02, 0xb2, 0xb1, 0xf9, 0x73, 0x54, 0x99, 0x7a, 0x6c,
-	0x14, 0x29, 0x41, 0x96, 0x8d, 0x49, 0x96, 0xc3, 0x09, 0x6b, 0x33, 0xcc, 0x08, 0xda, 0xc7, 0x37,
-	0xf7, 0x0d, 0xe7, 0xf6, 0xbe, 0xe1, 0x7c, 0xbf, 0x6f, 0x38, 0x9f, 0x1e, 0x1a, 0x85, 0xdb, 0x87,
-	0x46, 0xe1, 0xcb, 0x43, 0xa3, 0xf0, 0xce, 0x9d, 0x6a, 0xf5, 0xa9, 0x48, 0x35, 0xcb, 0xce, 0x19,
-	0x4d, 0xbc, 0x24, 0x1e, 0x74, 0x3d, 0x78, 0x18, 0xbc, 0x2b, 0xcf, 0xbc, 0x78, 0xd0, 0xf6, 0x6e,
-	0x09, 0x5e, 0xba, 0x83, 0x9f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xe1, 0x99, 0xb5, 0x80, 0x05,
-	0x00, 0x00,
+	// 809 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xcd, 0x4e, 0xeb, 0x46,
+	0x14, 0x8e, 0x83, 0x6f, 0xc2, 0x9d, 0xfc, 0x71, 0x87, 0x50, 0xf9, 0xa6, 0x6a, 0x88, 0x58, 0x40,
+	0x8a, 0xd4, 0x18, 0xc2, 0xae, 0x9b, 0x8a, 0xa4, 0xa5, 0xb0, 0x48, 0x8b, 0x0c, 0x6a, 0xa5, 0x6e,
+	0xdc, 0x89, 0x3d, 0xd8, 0xa3, 0xc6, 0x9e, 0xc8, 0x33, 0x09, 0x61, 0xd7, 0x47, 0xe8, 0x83, 0xf4,
+	0x41, 0x58, 0xa2, 0xae, 0xaa, 0xaa, 0x42, 0x15, 0xbc, 0x48, 0xe5, 0x33, 0x13, 0xe7, 0x07, 0xba,
+	0x41, 0x5d, 0xc5, 0xfe, 0xbe, 0xf3, 0x9d, 0xef, 0x1c, 0xcf, 0x9c, 0x13, 0xf4, 0xc9, 0x98, 0x06,
+	0xc1, 0x9d, 0x3d, 0x3d, 0xb6, 0x03, 0x1a, 0x53, 0xc1, 0x44, 0x67, 0x9c, 0x70, 0xc9, 0xf1, 0x26,
+	0xe0, 0x9d, 0xe9, 0x71, 0xa3, 0x1e, 0xf0, 0x80, 0x03, 0x68, 0xa7, 0x4f, 0x8a, 0x6f, 0xd4, 0x33,
+	0x9d, 0xbc, 0x1b, 0x53, 0xad, 0x6a, 0x6c, 0x67, 0x68, 0x24, 0x02, 0xf1, 0x22, 0x74, 0x48, 0xa4,
+	0x17, 0x6a, 0xb4, 0x91, 0xa1, 0x44, 0x4a, 0x2a, 0x24, 0x91, 0x8c, 0xc7, 0x2f, 0xd2, 0x8c, 0x39,
+	0x1f, 0x29, 0x70, 0xef, 0xf7, 0x02, 0x2a, 0x5c, 0x92, 0x84, 0x44, 0x02, 0x7f, 0x44, 0xaa, 0x3c,
+	0x97, 0xf9, 0x96, 0xd1, 0x32, 0xda, 0xef, 0x9d, 0x22, 0xbc, 0x5f, 0xf8, 0xf8, 0x08, 0xd5, 0x3d,
+	0x1e, 0xcb, 0x84, 0x78, 0xd2, 0x15, 0x7c, 0x92, 0x78, 0xd4, 0x0d, 0x89, 0x08, 0xad, 0x3c, 0x84,
+	0xe1, 0x39, 0x77, 0x05, 0xd4, 0x39, 0x11, 0x21, 0x3e, 0x40, 0x35, 0x21, 0x49, 0x22, 0x5d, 0x19,
+	0x26, 0x54, 0x84, 0x7c, 0xe4, 0x5b, 0x1b, 0x2d, 0xa3, 0x6d, 0x3a, 0x55, 0x80, 0xaf, 0xe7, 0x28,
+	0xfe, 0x1c, 0x6d, 0x51, 0x19, 0xd2, 0x84, 0x4e, 0x22, 0x97, 0xf8, 0x7e, 0x42, 0x85, 0xb0, 0x4c,
+	0x48, 0x5b, 0x9b, 0xe3, 0xa7, 0x0a, 0xc6, 0xfb, 0xa8, 0x36, 0x4c, 0x98, 0x1f, 0x50, 0xd7, 0x0b,
+	0x09, 0x8b, 0xd3, 0x3a, 0xdf, 0x41, 0xce, 0x8a, 0x82, 0xfb, 0x29, 0x7a, 0xe1, 0xe3, 0x2e, 0xda,
+	0x11, 0x2c, 0x88, 0xa9, 0xef, 0x4e, 0xc9, 0x48, 0x50, 0x29, 0xdc, 0x5b, 0x16, 0xfb, 0xfc, 0xd6,
+	0x2a, 0x40, 0xf4, 0xb6, 0x22, 0x7f, 0x50, 0xdc, 0x8f, 0x40, 0x2d, 0x69, 0xe0, 0x73, 0xd2, 0x4c,
+	0x53, 0x5c, 0xd6, 0xf4, 0x14, 0xa7, 0x35, 0x47, 0xa8, 0xae, 0x35, 0xde, 0x88, 0xb0, 0x28, 0x93,
+	0x6c, 0x82, 0x04, 0x2b, 0xae, 0x0f, 0x94, 0x56, 0x0c, 0xd1, 0x8e, 0x18, 0x11, 0x11, 0xba, 0x37,
+	0xe9, 0xe7, 0x62, 0x3c, 0xd6, 0x15, 0x5a, 0xef, 0x5b, 0x46, 0xbb, 0xdc, 0xeb, 0xdc, 0x3f, 0xee,
+	0xe6, 0xfe, 0x7a, 0xdc, 0xdd, 0x0f, 0x98, 0x0c, 0x27, 0xc3, 0x8e, 0xc7, 0x23, 0xdb, 0xe3, 0x22,
+	0xe2, 0x42, 0xff, 0x7c, 0x21, 0xfc, 0x5f, 0xf4, 0xd5, 0xf8, 0x9a, 0x7a, 0xce, 0x36, 0x24, 0x3b,
+	0xd3, 0xb9, 0x54, 0x43, 0xf8, 0x67, 0x54, 0x5f, 0xf3, 0x80, 0x8e, 0x2c, 0xf4, 0x26, 0x0b, 0xbc,
+	0x62, 0x01, 0xfd, 0xbf, 0xe2, 0x00, 0xfd, 0x5b, 0xa5, 0xff, 0xc1, 0x01, 0x3e, 0x17, 0xbe, 0x45,
+	0xad, 0x75, 0x07, 0x1e, 0xdf, 0x8c, 0x98, 0x27, 0x59, 0x1c, 0x68, 0xb7, 0xf2, 0x9b, 0xdc, 0x3e,
+	0x5b, 0x75, 0x5b, 0x64, 0x55, 0xc6, 0x16, 0x2a, 0x0a, 0xc9, 0xc7, 0x63, 0xea, 0x5b, 0x95, 0x96,
+	0xd1, 0xde, 0x74, 0xe6, 0xaf, 0x5f, 0x9a, 0xbf, 0xfe, 0xdd, 0xca, 0xed, 0xfd, 0x61, 0xa2, 0xf2,
+	0xb7, 0x6a, 0xa4, 0xaf, 0x24, 0x91, 0x14, 0xb7, 0x51, 0x61, 0x0c, 0xe3, 0x03, 0x23, 0x53, 0xea,
+	0x6e, 0x75, 0xe6, 0x23, 0xde, 0x51, 0x63, 0xe5, 0x68, 0x1e, 0x1f, 0xa2, 0xa2, 0xbe, 0x8e, 0x56,
+	0xbe, 0xb5, 0xb1, 0x1a, 0xaa, 0x8e, 0xce, 0x99, 0x07, 0xe0, 0x3e, 0xaa, 0xa9, 0x47, 0xe8, 0x9b,
+	0x25, 0x91, 0xb0, 0x36, 0x40, 0xd3, 0x58, 0x68, 0x06, 0x22, 0x50, 0xb2, 0xbe, 0x0a, 0x71, 0xaa,
+	0xd3, 0xe5, 0x57, 0x81, 0x4f, 0x50, 0x51, 0xdf, 0x65, 0xcb, 0x04, 0xf1, 0xc7, 0x85, 0xf8, 0xfb,
+	0x89, 0x0c, 0x38, 0x8b, 0x83, 0xeb, 0x19, 0x1c, 0xa9, 0x33, 0x8f, 0xc4, 0x67, 0xa8, 0x0a, 0x8f,
+	0x0b, 0xe3, 0x77, 0xeb, 0xda, 0x81, 0x08, 0xb4, 0x07, 0x68, 0x7b, 0x66, 0x7a, 0x04, 0x4e, 0x05,
+	0x64, 0x99, 0xf9, 0x57, 0xa8, 0xbc, 0xb4, 0x81, 0x84, 0x55, 0x80, 0x2c, 0x3b, 0x8b, 0x2c, 0xa7,
+	0x0b, 0x56, 0x67, 0x58, 0x11, 0xe0, 0x1e, 0x2a, 0x73, 0x5d, 0xa4, 0x2b, 0x67, 0xc2, 0x2a, 0xfe,
+	0x77, 0x0b, 0xdf, 0xc4, 0x32, 0xb9, 0xd3, 0x49, 0x4a, 0x3c, 0x83, 0x05, 0x3e, 0x40, 0x5b, 0x7a,
+	0x09, 0xcd, 0xdc, 0x74, 0xe7, 0xa5, 0x1b, 0x43, 0x0d, 0x67, 0x45, 0x6d, 0xa1, 0xd9, 0x25, 0xe7,
+	0xa3, 0x0b, 0x1f, 0x1f, 0xa2, 0x0f, 0x2a, 0x50, 0xf5, 0x1e, 0xf3, 0xd8, 0xa3, 0x30, 0x93, 0xa6,
+	0xa3, 0xd6, 0x18, 0x74, 0xfa, 0x5d, 0x0a, 0xe3, 0x4b, 0x84, 0xa7, 0x7c, 0xe2, 0x85, 0x34, 0x71,
+	0x89, 0xe7, 0xf1, 0x49, 0x9c, 0xde, 0x1e, 0x98, 0xae, 0x52, 0xf7, 0xd3, 0xa5, 0x23, 0x55, 0x31,
+	0xa7, 0x59, 0x88, 0x2e, 0xf0, 0xc3, 0x74, 0x9d, 0xd8, 0x1b, 0xa0, 0xda, 0x5a, 0x33, 0xb8, 0x8a,
+	0xf2, 0x7a, 0x0b, 0x9b, 0x4e, 0x9e, 0xa5, 0x05, 0xe6, 0xe5, 0x0c, 0xd6, 0x6d, 0xa9, 0x5b, 0x7f,
+	0xf5, 0x18, 0x55, 0xf6, 0xbc, 0x9c, 0xf5, 0xce, 0xef, 0x9f, 0x9a, 0xc6, 0xc3, 0x53, 0xd3, 0xf8,
+	0xe7, 0xa9, 0x69, 0xfc, 0xf6, 0xdc, 0xcc, 0x3d, 0x3c, 0x37, 0x73, 0x7f, 0x3e, 0x37, 0x73, 0x3f,
+	0x75, 0x96, 0x86, 0x64, 0xc0, 0x62, 0x49, 0x93, 0x6b, 0x4a, 0x22, 0x3b, 0x0a, 0x27, 0x43, 0x1b,
+	0x56, 0xaa, 0x3d, 0xb3, 0xd5, 0x9f, 0x04, 0x0c, 0xcc, 0xb0, 0x00, 0xff, 0x11, 0x27, 0xff, 0x0e,
+	0x00, 0x55, 0x61, 0xcc, 0x49, 0xcf, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -428,6 +522,40 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.VoucherAccounting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.StartBatchNonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartBatchNonce))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StartTxPoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartTxPoolId))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.OutgoingTxs) > 0 {
+		for iNdEx := len(m.OutgoingTxs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutgoingTxs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Attestations) > 0 {
 		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -513,6 +641,44 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *OutgoingTxEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingTxEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingTxEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -611,6 +777,34 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.OutgoingTxs) > 0 {
+		for _, e := range m.OutgoingTxs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StartTxPoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.StartTxPoolId))
+	}
+	if m.StartBatchNonce != 0 {
+		n += 1 + sovGenesis(uint64(m.StartBatchNonce))
+	}
+	l = m.VoucherAccounting.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *OutgoingTxEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovGenesis(uint64(m.Id))
+	}
+	l = m.Tx.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -1251,6 +1445,216 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutgoingTxs = append(m.OutgoingTxs, OutgoingTxEntry{})
+			if err := m.OutgoingTxs[len(m.OutgoingTxs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTxPoolId", wireType)
+			}
+			m.StartTxPoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTxPoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBatchNonce", wireType)
+			}
+			m.StartBatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartBatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherAccounting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VoucherAccounting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OutgoingTxEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingTxEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingTxEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tx", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"strings"
+
 	oraclekeeper "github.com/MinterTeam/mhub/chain/x/oracle/keeper"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -84,6 +87,18 @@ var (
 	KeyOrchestratorAddress = []byte{0xe8}
 
 	LockedCoinsKey = []byte{0x19}
+
+	// VoucherAccountingKey indexes the vouchers minted, burned and released by the module
+	VoucherAccountingKey = []byte{0x1a}
+
+	// OutgoingTXSenderIndexKey indexes the outgoing pool by sender
+	OutgoingTXSenderIndexKey = []byte{0x1b}
+
+	// OutgoingTXReceiverIndexKey indexes the outgoing pool by destination address
+	OutgoingTXReceiverIndexKey = []byte{0x1c}
+
+	// OutgoingTXHashIndexKey indexes the outgoing pool by the hash of the inbound tx
+	OutgoingTXHashIndexKey = []byte{0x1d}
 )
 
 // GetOrchestratorAddressKey returns the following key format
@@ -182,6 +197,27 @@ func GetOutgoingTxPoolKey(id uint64) []byte {
 	return append(OutgoingTXPoolKey, sdk.Uint64ToBigEndian(id)...)
 }
 
+// GetOutgoingTxIndexPrefix returns the prefix of the outgoing pool index entries with the given value, the value is
+// prefixed with its uvarint encoded length so that the values which are prefixes of each other do not overlap
+// prefix  length  value
+// [0x1b][42][hub1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetOutgoingTxIndexPrefix(indexKey []byte, value string) []byte {
+	value = strings.ToLower(value)
+
+	key := make([]byte, len(indexKey)+binary.MaxVarintLen64, len(indexKey)+binary.MaxVarintLen64+len(value))
+	copy(key, indexKey)
+	n := binary.PutUvarint(key[len(indexKey):], uint64(len(value)))
+	key = key[:len(indexKey)+n]
+	return append(key, value...)
+}
+
+// GetOutgoingTxIndexKey returns the following key format
+// prefix  length  value                                         id
+// [0x1b][42][hub1ahx7f8wyertuus9r20284ej0asrs085case3kn][0 0 0 0 0 0 0 1]
+func GetOutgoingTxIndexKey(indexKey []byte, value string, id uint64) []byte {
+	return append(GetOutgoingTxIndexPrefix(indexKey, value), sdk.Uint64ToBigEndian(id)...)
+}
+
 // GetOutgoingTxBatchKey returns the following key format
 // prefix     nonce                     eth-contract-address
 // [0xa][0 0 0 0 0 0 0 1][0xc783df8a850f42e7F7e57013759C285caa701eB6]
@@ -414,6 +414,68 @@ func (m *GenericClaim) GetEventClaimer() string {
 	return ""
 }
 
+// VoucherAccounting sums up the vouchers the module has minted and burned. Released are the burned vouchers which are
+// no longer pending in the outgoing pool, either executed on the other chain or refunded.
+type VoucherAccounting struct {
+	Minted   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	Burned   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	Released github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+}
+
+func (m *VoucherAccounting) Reset()         { *m = VoucherAccounting{} }
+func (m *VoucherAccounting) String() string { return proto.CompactTextString(m) }
+func (*VoucherAccounting) ProtoMessage()    {}
+func (*VoucherAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1488ca6080c6185d, []int{7}
+}
+func (m *VoucherAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherAccounting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoucherAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherAccounting.Merge(m, src)
+}
+func (m *VoucherAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherAccounting proto.InternalMessageInfo
+
+func (m *VoucherAccounting) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+func (m *VoucherAccounting) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *VoucherAccounting) GetReleased() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Released
+	}
+	return nil
+}
+
 type ColdStorageTransferProposal struct {
 	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
@@ -421,7 +483,7 @@ type ColdStorageTransferProposal struct {
 func (m *ColdStorageTransferProposal) Reset()      { *m = ColdStorageTransferProposal{} }
 func (*ColdStorageTransferProposal) ProtoMessage() {}
 func (*ColdStorageTransferProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1488ca6080c6185d, []int{7}
+	return fileDescriptor_1488ca6080c6185d, []int{8}
 }
 func (m *ColdStorageTransferProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,53 +520,57 @@ func init() {
 	proto.RegisterType((*AttestationHistory)(nil), "peggy.v1.AttestationHistory")
 	proto.RegisterType((*AttestationSigners)(nil), "peggy.v1.AttestationSigners")
 	proto.RegisterType((*GenericClaim)(nil), "peggy.v1.GenericClaim")
+	proto.RegisterType((*VoucherAccounting)(nil), "peggy.v1.VoucherAccounting")
 	proto.RegisterType((*ColdStorageTransferProposal)(nil), "peggy.v1.ColdStorageTransferProposal")
 }
 
 func init() { proto.RegisterFile("peggy/v1/types.proto", fileDescriptor_1488ca6080c6185d) }
 
 var fileDescriptor_1488ca6080c6185d = []byte{
-	// 625 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xc1, 0x53, 0xd4, 0x3e,
-	0x18, 0x6d, 0x7f, 0xc0, 0x02, 0x61, 0x19, 0xf8, 0x45, 0x74, 0x96, 0x45, 0xbb, 0x4c, 0xbd, 0xac,
-	0x07, 0x5b, 0x17, 0x46, 0x66, 0xf4, 0x24, 0xec, 0x8c, 0xe2, 0x01, 0xc7, 0x09, 0x0c, 0x07, 0x2f,
-	0x4c, 0xb6, 0xfd, 0x68, 0x33, 0x6c, 0x93, 0x4e, 0x92, 0x5d, 0xdd, 0xbf, 0x40, 0x8f, 0x1c, 0x3d,
-	0x72, 0xf6, 0x2f, 0xe1, 0xc8, 0xd1, 0x93, 0x3a, 0x70, 0xf1, 0xcf, 0x70, 0x9a, 0xb4, 0xec, 0x2e,
-	0x7a, 0x6a, 0xf2, 0xde, 0xcb, 0x4b, 0xbe, 0x7e, 0xdf, 0x43, 0x6b, 0x39, 0x24, 0xc9, 0x28, 0x1c,
-	0x76, 0x42, 0x3d, 0xca, 0x41, 0x05, 0xb9, 0x14, 0x5a, 0xe0, 0x05, 0x83, 0x06, 0xc3, 0x4e, 0xf3,
-	0xde, 0x2d, 0x9f, 0xa9, 0xa4, 0xa4, 0x9b, 0xcd, 0x5b, 0x90, 0x6a, 0x0d, 0x4a, 0x53, 0xcd, 0x04,
-	0x2f, 0x39, 0x2f, 0x12, 0x2a, 0x13, 0x2a, 0xec, 0x51, 0x05, 0xe1, 0xb0, 0xd3, 0x03, 0x4d, 0x3b,
-	0x61, 0x24, 0x58, 0xc5, 0xaf, 0x25, 0x22, 0x11, 0x66, 0x19, 0x16, 0x2b, 0x8b, 0xfa, 0x04, 0xad,
-	0xec, 0x49, 0x16, 0x27, 0x70, 0x4c, 0xfb, 0x2c, 0xa6, 0x5a, 0x48, 0xbc, 0x86, 0xe6, 0x72, 0xf1,
-	0x11, 0x64, 0xc3, 0xdd, 0x74, 0xdb, 0xb3, 0xc4, 0x6e, 0xf0, 0x13, 0xb4, 0x0a, 0x3a, 0x05, 0x09,
-	0x83, 0xec, 0x84, 0xc6, 0xb1, 0x04, 0xa5, 0x1a, 0xff, 0x6d, 0xba, 0xed, 0x45, 0xb2, 0x52, 0xe1,
-	0xbb, 0x16, 0xf6, 0xcf, 0x50, 0xed, 0x98, 0xf6, 0x15, 0xe8, 0xc2, 0x8a, 0x0b, 0x1e, 0x41, 0x65,
-	0x65, 0x36, 0x78, 0x1b, 0xcd, 0x67, 0x90, 0xf5, 0x40, 0x16, 0x0e, 0x33, 0xed, 0xa5, 0xad, 0xf5,
-	0xa0, 0x2a, 0x3b, 0xb8, 0xf3, 0x18, 0x52, 0x29, 0xf1, 0x03, 0x54, 0x4b, 0x81, 0x25, 0xa9, 0x6e,
-	0xcc, 0x18, 0xaf, 0x72, 0xe7, 0xbf, 0x46, 0xcb, 0xf6, 0xb2, 0x7d, 0xa6, 0xb4, 0x90, 0x23, 0xfc,
-	0x1c, 0xcd, 0x0f, 0x0d, 0xa0, 0x1a, 0xae, 0x71, 0xdf, 0x18, 0xbb, 0x4f, 0x29, 0xdf, 0x6a, 0xc8,
-	0x48, 0xa5, 0xf5, 0x07, 0xe8, 0xff, 0xbf, 0x58, 0xdc, 0x46, 0x35, 0xcb, 0x9b, 0x02, 0x96, 0xb6,
-	0x56, 0xef, 0x5a, 0x91, 0x92, 0xc7, 0x3b, 0x68, 0x21, 0x12, 0xfc, 0x94, 0xc9, 0xac, 0x2a, 0xaa,
-	0x39, 0xd6, 0x1e, 0xa8, 0xc4, 0xca, 0xbb, 0x56, 0x42, 0x6e, 0xb5, 0xfe, 0x67, 0x17, 0xe1, 0xdd,
-	0x71, 0x2f, 0xab, 0x22, 0x5e, 0xa0, 0xfa, 0x44, 0x87, 0xab, 0x4a, 0xee, 0x8f, 0x2d, 0x27, 0xce,
-	0x90, 0x29, 0x29, 0xde, 0x41, 0xf3, 0x8a, 0x25, 0x7c, 0xfc, 0x77, 0x1f, 0xfe, 0xf3, 0xd4, 0xa1,
-	0xd5, 0x90, 0x4a, 0xec, 0xbf, 0x9a, 0x7a, 0x48, 0x49, 0x63, 0x8c, 0x66, 0x8b, 0x0a, 0xcd, 0x03,
-	0x16, 0x89, 0x59, 0x17, 0xad, 0x30, 0x87, 0x62, 0x73, 0xc1, 0x02, 0x29, 0x77, 0x45, 0x2d, 0xf5,
-	0x37, 0xc0, 0x41, 0xb2, 0xa8, 0xdb, 0xa7, 0x2c, 0xc3, 0x2d, 0xb4, 0x04, 0x43, 0xe0, 0xfa, 0x64,
-	0x72, 0x08, 0x90, 0x81, 0xde, 0x99, 0x49, 0x78, 0x84, 0x50, 0x54, 0x28, 0x4f, 0x8a, 0x0c, 0x98,
-	0x71, 0x9a, 0x23, 0x8b, 0x06, 0x39, 0x1a, 0xe5, 0x50, 0x5c, 0x9e, 0x52, 0x95, 0x9a, 0x8e, 0xd7,
-	0x89, 0x59, 0xe3, 0xc7, 0x68, 0xd9, 0x7a, 0x1a, 0x19, 0xc8, 0xc6, 0xac, 0x19, 0xc2, 0xba, 0x01,
-	0xbb, 0x16, 0xf3, 0xcf, 0x5d, 0xb4, 0xd1, 0x15, 0xfd, 0xf8, 0x50, 0x0b, 0x49, 0x13, 0x38, 0x92,
-	0x94, 0xab, 0x53, 0x90, 0xef, 0xa5, 0xc8, 0x85, 0xa2, 0x7d, 0x1c, 0xa1, 0x1a, 0xcd, 0xc4, 0x80,
-	0xeb, 0xf2, 0xc7, 0xae, 0x07, 0x36, 0x3c, 0x41, 0x11, 0x9e, 0xa0, 0x0c, 0x4f, 0xd0, 0x15, 0x8c,
-	0xef, 0x3d, 0xbb, 0xfc, 0xd1, 0x72, 0xbe, 0xfd, 0x6c, 0xb5, 0x13, 0xa6, 0xd3, 0x41, 0x2f, 0x88,
-	0x44, 0x16, 0x96, 0x49, 0xb3, 0x9f, 0xa7, 0x2a, 0x3e, 0x2b, 0x33, 0x5c, 0x1c, 0x50, 0xa4, 0xb4,
-	0x7e, 0x59, 0xff, 0x72, 0xd1, 0x72, 0xbe, 0x5e, 0xb4, 0x9c, 0xdf, 0x17, 0x2d, 0x67, 0x6f, 0xff,
-	0xf2, 0xda, 0x73, 0xaf, 0xae, 0x3d, 0xf7, 0xd7, 0xb5, 0xe7, 0x9e, 0xdf, 0x78, 0xce, 0xd5, 0x8d,
-	0xe7, 0x7c, 0xbf, 0xf1, 0x9c, 0x0f, 0xc1, 0x84, 0xf3, 0x01, 0xe3, 0x1a, 0xe4, 0x11, 0xd0, 0x2c,
-	0xcc, 0xd2, 0x41, 0x2f, 0x8c, 0x52, 0xca, 0x78, 0xf8, 0x29, 0xb4, 0xb9, 0x37, 0xb7, 0xf4, 0x6a,
-	0x26, 0xb9, 0xdb, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xe5, 0x9e, 0xb4, 0x42, 0x04, 0x00,
-	0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x4f, 0x1b, 0x3b,
+	0x14, 0xcd, 0xf0, 0x11, 0x82, 0x09, 0x02, 0xfc, 0x78, 0x4f, 0x21, 0xbc, 0x26, 0x68, 0xba, 0x49,
+	0x17, 0x9d, 0x69, 0x40, 0x45, 0x6a, 0x57, 0x85, 0x48, 0x2d, 0x5d, 0x50, 0x55, 0x06, 0xb1, 0xe8,
+	0x06, 0x39, 0x33, 0x97, 0x19, 0x8b, 0xcc, 0x38, 0xb2, 0x3d, 0x69, 0xf3, 0x0b, 0xda, 0x25, 0xcb,
+	0x2e, 0x59, 0xf7, 0x87, 0x54, 0x2c, 0x59, 0x76, 0xd5, 0x56, 0xb0, 0xe9, 0xcf, 0xa8, 0x6c, 0xcf,
+	0x24, 0x01, 0xba, 0xa4, 0xab, 0xb1, 0xcf, 0x3d, 0x3e, 0x77, 0xce, 0xbd, 0xbe, 0x46, 0xab, 0x7d,
+	0x88, 0xa2, 0xa1, 0x3f, 0x68, 0xfb, 0x6a, 0xd8, 0x07, 0xe9, 0xf5, 0x05, 0x57, 0x1c, 0x57, 0x0c,
+	0xea, 0x0d, 0xda, 0xf5, 0x7f, 0x46, 0xf1, 0x44, 0x46, 0x79, 0xb8, 0x5e, 0x1f, 0x81, 0x54, 0x29,
+	0x90, 0x8a, 0x2a, 0xc6, 0xd3, 0x3c, 0xd6, 0x08, 0xb8, 0x4c, 0xb8, 0xf4, 0xbb, 0x54, 0x82, 0x3f,
+	0x68, 0x77, 0x41, 0xd1, 0xb6, 0x1f, 0x70, 0x56, 0xc4, 0x57, 0x23, 0x1e, 0x71, 0xb3, 0xf4, 0xf5,
+	0xca, 0xa2, 0x2e, 0x41, 0x4b, 0xbb, 0x82, 0x85, 0x11, 0x1c, 0xd1, 0x1e, 0x0b, 0xa9, 0xe2, 0x02,
+	0xaf, 0xa2, 0xd9, 0x3e, 0x7f, 0x0f, 0xa2, 0xe6, 0x6c, 0x38, 0xad, 0x19, 0x62, 0x37, 0xf8, 0x11,
+	0x5a, 0x06, 0x15, 0x83, 0x80, 0x2c, 0x39, 0xa6, 0x61, 0x28, 0x40, 0xca, 0xda, 0xd4, 0x86, 0xd3,
+	0x9a, 0x27, 0x4b, 0x05, 0xbe, 0x63, 0x61, 0xf7, 0x14, 0x95, 0x8f, 0x68, 0x4f, 0x82, 0xd2, 0x52,
+	0x29, 0x4f, 0x03, 0x28, 0xa4, 0xcc, 0x06, 0x6f, 0xa1, 0xb9, 0x04, 0x92, 0x2e, 0x08, 0xad, 0x30,
+	0xdd, 0x5a, 0xd8, 0x5c, 0xf3, 0x0a, 0xdb, 0xde, 0xad, 0x9f, 0x21, 0x05, 0x13, 0xff, 0x87, 0xca,
+	0x31, 0xb0, 0x28, 0x56, 0xb5, 0x69, 0xa3, 0x95, 0xef, 0xdc, 0x97, 0x68, 0xd1, 0x26, 0xdb, 0x63,
+	0x52, 0x71, 0x31, 0xc4, 0x4f, 0xd1, 0xdc, 0xc0, 0x00, 0xb2, 0xe6, 0x18, 0xf5, 0xf5, 0xb1, 0xfa,
+	0x0d, 0xe6, 0x6b, 0x05, 0x09, 0x29, 0xb8, 0x6e, 0x86, 0x56, 0xee, 0x44, 0x71, 0x0b, 0x95, 0x6d,
+	0xdc, 0x18, 0x58, 0xd8, 0x5c, 0xbe, 0x2d, 0x45, 0xf2, 0x38, 0xde, 0x46, 0x95, 0x80, 0xa7, 0x27,
+	0x4c, 0x24, 0x85, 0xa9, 0xfa, 0x98, 0xbb, 0x2f, 0x23, 0x4b, 0xef, 0x58, 0x0a, 0x19, 0x71, 0xdd,
+	0x8f, 0x0e, 0xc2, 0x3b, 0xe3, 0x5e, 0x16, 0x26, 0x9e, 0xa1, 0xea, 0x44, 0x87, 0x0b, 0x27, 0xff,
+	0x8e, 0x25, 0x27, 0xce, 0x90, 0x1b, 0x54, 0xbc, 0x8d, 0xe6, 0x24, 0x8b, 0xd2, 0x71, 0x75, 0xff,
+	0xff, 0xe3, 0xa9, 0x03, 0xcb, 0x21, 0x05, 0xd9, 0x7d, 0x81, 0xf0, 0xdd, 0x30, 0xc6, 0x68, 0x46,
+	0x3b, 0x34, 0x3f, 0x30, 0x4f, 0xcc, 0x5a, 0xb7, 0xc2, 0x1c, 0x0a, 0x4d, 0x82, 0x0a, 0xc9, 0x77,
+	0xda, 0x4b, 0xf5, 0x15, 0xa4, 0x20, 0x58, 0xd0, 0xe9, 0x51, 0x96, 0xe0, 0x26, 0x5a, 0x80, 0x01,
+	0xa4, 0xea, 0x78, 0xf2, 0x12, 0x20, 0x03, 0xbd, 0x31, 0x37, 0xe1, 0x01, 0x42, 0x81, 0x66, 0x1e,
+	0xeb, 0x19, 0x30, 0xd7, 0x69, 0x96, 0xcc, 0x1b, 0xe4, 0x70, 0xd8, 0x07, 0x9d, 0x3c, 0xa6, 0x32,
+	0x36, 0x1d, 0xaf, 0x12, 0xb3, 0xc6, 0x0f, 0xd1, 0xa2, 0xd5, 0x34, 0x34, 0x10, 0xb5, 0x19, 0x73,
+	0x09, 0xab, 0x06, 0xec, 0x58, 0xcc, 0xfd, 0x3a, 0x85, 0x56, 0x8e, 0x78, 0x16, 0xc4, 0x20, 0x76,
+	0x82, 0x80, 0x67, 0xa9, 0x62, 0x69, 0x84, 0x03, 0x54, 0x4e, 0x58, 0xaa, 0x20, 0xcc, 0xcb, 0xb9,
+	0xe6, 0xd9, 0x91, 0xf1, 0xf4, 0xc8, 0x78, 0xf9, 0xc8, 0x78, 0x1d, 0xce, 0xd2, 0xdd, 0x27, 0x17,
+	0xdf, 0x9b, 0xa5, 0x2f, 0x3f, 0x9a, 0xad, 0x88, 0xa9, 0x38, 0xeb, 0x7a, 0x01, 0x4f, 0xfc, 0x7c,
+	0xbe, 0xec, 0xe7, 0xb1, 0x0c, 0x4f, 0xf3, 0xc9, 0xd5, 0x07, 0x24, 0xc9, 0xa5, 0x75, 0x92, 0x6e,
+	0x26, 0x8a, 0xe2, 0xdc, 0x77, 0x12, 0x2b, 0x8d, 0x23, 0x54, 0x11, 0xd0, 0x03, 0x2a, 0x21, 0xac,
+	0x4d, 0xdf, 0x7f, 0x9a, 0x91, 0xb8, 0x7b, 0xe6, 0xa0, 0xf5, 0x0e, 0xef, 0x85, 0x07, 0x8a, 0x0b,
+	0x1a, 0xc1, 0xa1, 0xa0, 0xa9, 0x3c, 0x01, 0xf1, 0x56, 0xf0, 0x3e, 0x97, 0xb4, 0xa7, 0xdd, 0xd2,
+	0x44, 0xd7, 0xf7, 0xaf, 0x94, 0xd4, 0x4a, 0x3f, 0xaf, 0x7e, 0x3a, 0x6f, 0x96, 0x3e, 0x9f, 0x37,
+	0x4b, 0xbf, 0xce, 0x9b, 0xa5, 0xdd, 0xbd, 0x8b, 0xab, 0x86, 0x73, 0x79, 0xd5, 0x70, 0x7e, 0x5e,
+	0x35, 0x9c, 0xb3, 0xeb, 0x46, 0xe9, 0xf2, 0xba, 0x51, 0xfa, 0x76, 0xdd, 0x28, 0xbd, 0xf3, 0x26,
+	0x94, 0xf7, 0x75, 0x37, 0xc4, 0x21, 0xd0, 0xc4, 0x4f, 0xe2, 0xac, 0xeb, 0x07, 0x31, 0x65, 0xa9,
+	0xff, 0xc1, 0xb7, 0x0f, 0xa8, 0xc9, 0xd2, 0x2d, 0x9b, 0x27, 0x70, 0xeb, 0xf7, 0x00, 0xbb, 0x3b,
+	0xa5, 0x43, 0x8b, 0x05, 0x00, 0x00,
 }
 
 func (m *BridgeValidator) Marshal() (dAtA []byte, err error) {
@@ -818,6 +884,71 @@ func (m *GenericClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoucherAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ColdStorageTransferProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -999,6 +1130,33 @@ func (m *GenericClaim) Size() (n int) {
 	return n
 }
 
+func (m *VoucherAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *ColdStorageTransferProposal) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1892,6 +2050,161 @@ func (m *GenericClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VoucherAccounting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VoucherAccounting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VoucherAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Released = append(m.Released, types.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *ColdStorageTransferProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
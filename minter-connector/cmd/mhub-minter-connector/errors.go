@@ -0,0 +1,24 @@
+package main
+
+// Relay steps which may fail
+const (
+	StepHubQuery    = "hub query"
+	StepMinterQuery = "minter query"
+	StepSigning     = "signing"
+	StepCosmosTx    = "cosmos tx"
+	StepMinterTx    = "minter tx"
+)
+
+// RelayError is returned by relay functions and tells which step of the relay has failed
+type RelayError struct {
+	Step string
+	Err  error
+}
+
+func (e *RelayError) Error() string {
+	return e.Step + ": " + e.Err.Error()
+}
+
+func (e *RelayError) Unwrap() error {
+	return e.Err
+}
@@ -2,8 +2,9 @@ package main
 
 import (
 	c "context"
-	"crypto/ecdsa"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"github.com/MinterTeam/mhub/chain/x/minter/types"
 	oracleTypes "github.com/MinterTeam/mhub/chain/x/oracle/types"
 	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client"
@@ -14,7 +15,9 @@ import (
 	"github.com/MinterTeam/minter-hub-connector/config"
 	"github.com/MinterTeam/minter-hub-connector/context"
 	"github.com/MinterTeam/minter-hub-connector/cosmos"
+	"github.com/MinterTeam/minter-hub-connector/metrics"
 	"github.com/MinterTeam/minter-hub-connector/minter"
+	"github.com/MinterTeam/minter-hub-connector/storage"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethCrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/tendermint/tendermint/libs/json"
@@ -23,13 +26,14 @@ import (
 	"google.golang.org/grpc/backoff"
 	"math"
 	"os"
+	"os/signal"
 	"strconv"
 	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
-const threshold = 667
-
 var cfg = config.Get()
 
 func main() {
@@ -45,84 +49,233 @@ func main() {
 		panic(err)
 	}
 
+	// the relayed events are verified against an independent node if there is one
+	minterVerifyClient := minterClient
+	if cfg.Minter.VerifyApiAddr != "" {
+		minterVerifyClient, err = http_client.New(cfg.Minter.VerifyApiAddr)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	cosmosConn, err := grpc.DialContext(c.Background(), cfg.Cosmos.GrpcAddr, grpc.WithInsecure(), grpc.WithConnectParams(grpc.ConnectParams{
 		Backoff:           backoff.DefaultConfig,
 		MinConnectTimeout: time.Second * 5,
 	}))
+	if err != nil {
+		panic(err)
+	}
+	defer cosmosConn.Close()
+
+	runCtx, cancel := c.WithCancel(c.Background())
+	defer cancel()
+
+	logger := log.NewTMLogger(log.NewSyncWriter(os.Stdout))
 
 	ctx := context.Context{
+		Context:                runCtx,
 		LastCheckedMinterBlock: cfg.Minter.StartBlock,
 		LastEventNonce:         cfg.Minter.StartEventNonce,
 		LastBatchNonce:         cfg.Minter.StartBatchNonce,
 		LastValsetNonce:        cfg.Minter.StartValsetNonce,
 		MinterMultisigAddr:     cfg.Minter.MultisigAddr,
+		State:                  &context.State{},
 		CosmosConn:             cosmosConn,
+		CosmosSubmitter:        cosmos.NewSubmitter(orcAddress, orcPriv, cosmosConn, logger),
 		MinterClient:           minterClient,
+		MinterVerifyClient:     minterVerifyClient,
 		OrcAddress:             orcAddress,
 		OrcPriv:                orcPriv,
 		MinterWallet:           minterWallet,
-		Logger:                 log.NewTMLogger(os.Stdout),
+		Logger:                 logger,
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		sig := <-signals
+		ctx.Logger.Info("Shutting down after the current step", "signal", sig.String())
+		cancel()
+	}()
+
+	store, err := storage.New(cfg.Storage.DataDir)
+	if err != nil {
+		panic(err)
+	}
+	defer store.Close()
+
+	health := metrics.NewHealth(cfg.Metrics.ProgressInterval)
+	if cfg.Metrics.Addr != "" {
+		go metrics.Serve(ctx, cfg.Metrics.Addr, health, ctx.Logger)
 	}
 
 	ctx.Logger.Info("Syncing with Minter")
 
-	ctx = minter.GetLatestMinterBlockAndNonce(ctx, cosmos.GetLastMinterNonce(orcAddress.String(), cosmosConn))
+	ctx, err = syncWithMinter(ctx, store)
+	if err != nil {
+		ctx.Logger.Error("Error while syncing with Minter", "err", err.Error())
+		os.Exit(1)
+	}
 
 	ctx.Logger.Info("Starting with block", "height", ctx.LastCheckedMinterBlock, "eventNonce", ctx.LastEventNonce, "batchNonce", ctx.LastBatchNonce, "valsetNonce", ctx.LastValsetNonce)
 
-	{
-		cosmosClient := types.NewQueryClient(ctx.CosmosConn)
-		response, err := cosmosClient.CurrentValset(c.TODO(), &types.QueryCurrentValsetRequest{})
-		if err != nil {
-			panic(err)
-		}
+	if err := updateMinterAddress(ctx); err != nil {
+		ctx.Logger.Error("Error while updating our Minter address", "err", err.Error())
+		os.Exit(1)
+	}
+
+	ctx.State.SetNonces(ctx.Nonces())
+
+	var wg sync.WaitGroup
+	wg.Add(3)
+
+	go func() {
+		defer wg.Done()
+		runRelay(ctx, "batches", cfg.Relay.BatchesInterval, func() error {
+			return relayBatches(ctx)
+		})
+	}()
 
-		hasAddress := false // TODO
-		for _, member := range response.Valset.Members {
-			if strings.ToLower(member.MinterAddress) == strings.ToLower(minterWallet.Address) {
-				hasAddress = true
-				break
+	go func() {
+		defer wg.Done()
+		runRelay(ctx, "valsets", cfg.Relay.ValsetsInterval, func() error {
+			return relayValsets(ctx)
+		})
+	}()
+
+	go func() {
+		defer wg.Done()
+
+		// the events relay owns the progress of the connector and publishes it to the shared state
+		eventsCtx := ctx
+		runRelay(ctx, "Minter events", cfg.Relay.EventsInterval, func() error {
+			newCtx, err := relayMinterEvents(eventsCtx)
+			if err == nil {
+				eventsCtx = newCtx
+				eventsCtx.State.SetNonces(eventsCtx.Nonces())
+				saveCheckpoint(eventsCtx, store)
+				health.MarkProgress()
+
+				ctx.Logger.Info("Last checked minter block", "height", eventsCtx.LastCheckedMinterBlock, "eventNonce", eventsCtx.LastEventNonce, "batchNonce", eventsCtx.LastBatchNonce, "valsetNonce", eventsCtx.LastValsetNonce)
 			}
+
+			observeNonces(eventsCtx)
+			return err
+		})
+	}()
+
+	wg.Wait()
+	ctx.Logger.Info("Stopped")
+}
+
+// runRelay calls relay every interval until ctx is done
+func runRelay(ctx context.Context, name string, interval time.Duration, relay func() error) {
+	for ctx.Err() == nil {
+		if err := relay(); err != nil {
+			ctx.Logger.Error("Error while relaying "+name, "err", err.Error())
 		}
 
-		if !hasAddress {
-			ctx.Logger.Info("Updating our Minter address", "address", minterWallet.Address)
+		select {
+		case <-ctx.Done():
+		case <-time.After(interval):
+		}
+	}
+}
 
-			privateKey, err := ethCrypto.HexToECDSA(minterWallet.PrivateKey)
-			if err != nil {
-				panic(err)
-			}
+func updateMinterAddress(ctx context.Context) error {
+	cosmosClient := types.NewQueryClient(ctx.CosmosConn)
+	response, err := cosmosClient.CurrentValset(c.TODO(), &types.QueryCurrentValsetRequest{})
+	if err != nil {
+		return &RelayError{Step: StepHubQuery, Err: err}
+	}
 
-			hash := ethCrypto.Keccak256(orcAddress.Bytes())
-			signature, err := types.NewMinterSignature(hash, privateKey)
-			if err != nil {
-				panic("signing cosmos address with Minter key")
-			}
-			publicKey := privateKey.Public()
-			publicKeyECDSA, ok := publicKey.(*ecdsa.PublicKey)
-			if !ok {
-				panic("casting public key to ECDSA")
-			}
-			minterAddress := ethCrypto.PubkeyToAddress(*publicKeyECDSA)
+	for _, member := range response.Valset.Members {
+		if strings.ToLower(member.MinterAddress) == strings.ToLower(ctx.MinterWallet.Address) {
+			return nil
+		}
+	}
+
+	ctx.Logger.Info("Updating our Minter address", "address", ctx.MinterWallet.Address)
+
+	privateKey, err := ethCrypto.HexToECDSA(ctx.MinterWallet.PrivateKey)
+	if err != nil {
+		return &RelayError{Step: StepSigning, Err: err}
+	}
+
+	hash := ethCrypto.Keccak256(ctx.OrcAddress.Bytes())
+	signature, err := types.NewMinterSignature(hash, privateKey)
+	if err != nil {
+		return &RelayError{Step: StepSigning, Err: err}
+	}
+	minterAddress := ethCrypto.PubkeyToAddress(privateKey.PublicKey)
+
+	err = ctx.CosmosSubmitter.Send(ctx, []sdk.Msg{
+		types.NewMsgSetMinterAddress("Mx"+minterAddress.String()[2:], ctx.OrcAddress, hex.EncodeToString(signature)),
+	}, true)
+	if err != nil {
+		return &RelayError{Step: StepCosmosTx, Err: err}
+	}
 
-			cosmos.SendCosmosTx([]sdk.Msg{
-				types.NewMsgSetMinterAddress("Mx"+minterAddress.String()[2:], orcAddress, hex.EncodeToString(signature)),
-			}, orcAddress, orcPriv, cosmosConn, ctx.Logger, true)
+	return nil
+}
+
+func syncWithMinter(ctx context.Context, store *storage.Store) (context.Context, error) {
+	hubNonce, err := cosmos.GetLastMinterNonce(ctx.OrcAddress.String(), ctx.CosmosConn)
+	if err != nil {
+		return ctx, &RelayError{Step: StepHubQuery, Err: err}
+	}
+
+	checkpoint, err := store.GetCheckpoint()
+	if err != nil {
+		return ctx, fmt.Errorf("reading checkpoint: %w", err)
+	}
+
+	if checkpoint != nil {
+		ctx.Logger.Info("Found local checkpoint", "height", checkpoint.LastCheckedMinterBlock, "eventNonce", checkpoint.LastEventNonce, "hubNonce", hubNonce)
+
+		switch {
+		case checkpoint.LastEventNonce == hubNonce+1:
+			return applyCheckpoint(ctx, checkpoint), nil
+		case checkpoint.LastEventNonce < hubNonce+1:
+			ctx.Logger.Info("Local checkpoint is behind the hub, continuing sync from it")
+			ctx = applyCheckpoint(ctx, checkpoint)
+		default:
+			ctx.Logger.Error("Local checkpoint is ahead of the hub, syncing from the start block")
 		}
 	}
 
-	// main loop
-	for {
-		relayBatches(ctx)
-		relayValsets(ctx)
-		ctx = relayMinterEvents(ctx)
+	ctx, err = minter.GetLatestMinterBlockAndNonce(ctx, hubNonce)
+	if err != nil {
+		return ctx, &RelayError{Step: StepMinterQuery, Err: err}
+	}
+
+	saveCheckpoint(ctx, store)
+
+	return ctx, nil
+}
+
+func applyCheckpoint(ctx context.Context, checkpoint *storage.Checkpoint) context.Context {
+	ctx.LastCheckedMinterBlock = checkpoint.LastCheckedMinterBlock
+	ctx.LastEventNonce = checkpoint.LastEventNonce
+	ctx.LastBatchNonce = checkpoint.LastBatchNonce
+	ctx.LastValsetNonce = checkpoint.LastValsetNonce
 
-		ctx.Logger.Info("Last checked minter block", "height", ctx.LastCheckedMinterBlock, "eventNonce", ctx.LastEventNonce, "batchNonce", ctx.LastBatchNonce, "valsetNonce", ctx.LastValsetNonce)
-		time.Sleep(2 * time.Second)
+	return ctx
+}
+
+func saveCheckpoint(ctx context.Context, store *storage.Store) {
+	err := store.SetCheckpoint(storage.Checkpoint{
+		LastCheckedMinterBlock: ctx.LastCheckedMinterBlock,
+		LastEventNonce:         ctx.LastEventNonce,
+		LastBatchNonce:         ctx.LastBatchNonce,
+		LastValsetNonce:        ctx.LastValsetNonce,
+	})
+	if err != nil {
+		ctx.Logger.Error("Error on saving checkpoint", "err", err.Error())
 	}
 }
 
-func relayBatches(ctx context.Context) {
+func relayBatches(ctx context.Context) error {
 	cosmosClient := types.NewQueryClient(ctx.CosmosConn)
 
 	{
@@ -130,8 +283,7 @@ func relayBatches(ctx context.Context) {
 			Address: ctx.OrcAddress.String(),
 		})
 		if err != nil {
-			ctx.Logger.Error("Error while getting last pending batch", "err", err.Error())
-			return
+			return &RelayError{Step: StepHubQuery, Err: err}
 		}
 
 		if response.Batch != nil {
@@ -142,15 +294,23 @@ func relayBatches(ctx context.Context) {
 				txData.AddItem(transaction.NewSendData().SetCoin(out.MinterToken.CoinId).MustSetTo(out.DestAddress).SetValue(out.MinterToken.Amount.BigInt()))
 			}
 
-			tx, _ := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
-			signedTx, _ := tx.SetNonce(response.Batch.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti).Sign(
+			tx, err := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
+			if err != nil {
+				return &RelayError{Step: StepSigning, Err: err}
+			}
+
+			tx.SetPayload([]byte(strconv.Itoa(int(response.Batch.BatchNonce))))
+			signedTx, err := tx.SetNonce(response.Batch.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti).Sign(
 				cfg.Minter.MultisigAddr,
 				ctx.MinterWallet.PrivateKey,
 			)
+			if err != nil {
+				return &RelayError{Step: StepSigning, Err: err}
+			}
 
 			sigData, err := signedTx.SingleSignatureData()
 			if err != nil {
-				panic(err)
+				return &RelayError{Step: StepSigning, Err: err}
 			}
 
 			msg := &types.MsgConfirmBatch{
@@ -160,40 +320,63 @@ func relayBatches(ctx context.Context) {
 				Signature:    sigData,
 			}
 
-			cosmos.SendCosmosTx([]sdk.Msg{msg}, ctx.OrcAddress, ctx.OrcPriv, ctx.CosmosConn, ctx.Logger, false)
+			if err := ctx.CosmosSubmitter.Send(ctx, []sdk.Msg{msg}, false); err != nil {
+				ctx.Logger.Error("Error while sending confirm", "err", err.Error())
+			} else {
+				metrics.ConfirmsSubmitted.WithLabelValues(metrics.ConfirmBatch).Inc()
+			}
 		}
 	}
 
 	latestBatches, err := cosmosClient.OutgoingTxBatches(c.Background(), &types.QueryOutgoingTxBatchesRequest{})
 	if err != nil {
-		ctx.Logger.Error("Error getting last batches", "err", err.Error())
-		return
+		return &RelayError{Step: StepHubQuery, Err: err}
+	}
+
+	lastValset, err := cosmosClient.LastValset(c.Background(), &types.QueryLastValsetRequest{})
+	if err != nil {
+		return &RelayError{Step: StepHubQuery, Err: err}
 	}
 
 	var oldestSignedBatch *types.OutgoingTxBatch
-	var oldestSignatures []*types.MsgConfirmBatch
+	var oldestSignatures map[string]string
 
 	for _, batch := range latestBatches.Batches {
 		sigs, err := cosmosClient.BatchConfirms(c.Background(), &types.QueryBatchConfirmsRequest{
 			Nonce: batch.BatchNonce,
 		})
 		if err != nil {
-			ctx.Logger.Error("Error while getting batch confirms", "err", err.Error())
-			return
+			return &RelayError{Step: StepHubQuery, Err: err}
+		}
+
+		signingData, err := batch.GetMinterSigningData(uint64(cfg.Minter.ChainID))
+		if err != nil {
+			return &RelayError{Step: StepSigning, Err: err}
 		}
 
-		if sigs.Size() > 0 { // todo: check if we have enough votes
-			oldestSignedBatch = batch
-			oldestSignatures = sigs.Confirms
+		var signatures []string
+		for _, sig := range sigs.Confirms {
+			signatures = append(signatures, sig.Signature)
+		}
+
+		signed := memberSignatures(lastValset.GetValset(), signingData, signatures)
+		weight, missing := collectedWeight(lastValset.GetValset(), signed)
+		if weight < types.MinterMultisigThreshold {
+			ctx.Logger.Info("Not enough signatures for batch", "batch nonce", batch.BatchNonce, "weight", weight, "missing", strings.Join(missing, ","))
+			continue
 		}
+
+		oldestSignedBatch = batch
+		oldestSignatures = signed
 	}
 
 	if oldestSignedBatch == nil {
-		return
+		return nil
 	}
 
-	if oldestSignedBatch.BatchNonce < ctx.LastBatchNonce {
-		return
+	// the last batch nonce is the last batch executed on Minter
+	if oldestSignedBatch.BatchNonce <= ctx.State.Nonces().LastBatchNonce {
+		return nil
 	}
 
 	ctx.Logger.Info("Sending batch to Minter")
@@ -203,50 +386,43 @@ func relayBatches(ctx context.Context) {
 		txData.AddItem(transaction.NewSendData().SetCoin(out.MinterToken.CoinId).MustSetTo(out.DestAddress).SetValue(out.MinterToken.Amount.BigInt()))
 	}
 
-	tx, _ := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
-	tx.SetNonce(oldestSignedBatch.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti)
-	signedTx, err := tx.Sign(cfg.Minter.MultisigAddr)
+	tx, err := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
 	if err != nil {
-		panic(err)
+		return &RelayError{Step: StepSigning, Err: err}
 	}
 
-	lastValset, err := cosmosClient.LastValset(c.Background(), &types.QueryLastValsetRequest{})
+	tx.SetNonce(oldestSignedBatch.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti)
+	tx.SetPayload([]byte(strconv.Itoa(int(oldestSignedBatch.BatchNonce))))
+	signedTx, err := tx.Sign(cfg.Minter.MultisigAddr)
 	if err != nil {
-		panic(err)
+		return &RelayError{Step: StepSigning, Err: err}
 	}
 
-	// Check if signer is in the last confirmed valset
-	for _, sig := range oldestSignatures {
-		for _, member := range lastValset.GetValset().GetMembers() {
-			if strings.ToLower(member.MinterAddress) == strings.ToLower(sig.MinterSigner) {
-				signedTx, err = signedTx.AddSignature(sig.Signature)
-				if err != nil {
-					panic(err)
-				}
+	// Only the signatures of the members of the last confirmed valset are added
+	for _, member := range lastValset.GetValset().GetMembers() {
+		if signature, ok := oldestSignatures[strings.ToLower(member.MinterAddress)]; ok {
+			signedTx, err = signedTx.AddSignature(signature)
+			if err != nil {
+				return &RelayError{Step: StepSigning, Err: err}
 			}
 		}
 	}
 
 	encodedTx, err := signedTx.Encode()
 	if err != nil {
-		panic(err)
+		return &RelayError{Step: StepSigning, Err: err}
 	}
 
 	ctx.Logger.Debug("Batch tx", "tx", encodedTx)
-	response, err := ctx.MinterClient.SendTransaction(encodedTx)
-	if err != nil {
-		code, body, err := http_client.ErrorBody(err)
-		if err != nil {
-			ctx.Logger.Error("Error on sending Minter Tx", "err", err.Error())
-		} else {
-			ctx.Logger.Error("Error on sending Minter Tx", "code", code, "err", body.Error.Message)
-		}
-	} else if response.Code != 0 {
-		ctx.Logger.Error("Error on sending Minter Tx", "err", response.Log)
+	if err := sendMinterTx(ctx, encodedTx); err != nil {
+		return err
 	}
+
+	metrics.BatchesRelayed.Inc()
+	return nil
 }
 
-func relayValsets(ctx context.Context) {
+func relayValsets(ctx context.Context) error {
 	cosmosClient := types.NewQueryClient(ctx.CosmosConn)
 
 	{
@@ -254,42 +430,45 @@ func relayValsets(ctx context.Context) {
 			Address: ctx.OrcAddress.String(),
 		})
 		if err != nil {
-			ctx.Logger.Error("Error while getting last pending valset", "err", err.Error())
-			return
+			return &RelayError{Step: StepHubQuery, Err: err}
 		}
 
 		if response.Valset != nil {
 			ctx.Logger.Info("Sending valset confirm", "valset nonce", response.Valset.Nonce)
 
 			txData := transaction.NewEditMultisigData()
-			txData.Threshold = threshold
-
-			totalPower := uint64(0)
-			for _, val := range response.Valset.Members {
-				totalPower += val.Power
-			}
+			txData.Threshold = types.MinterMultisigThreshold
 
+			totalPower := types.BridgeValidators(response.Valset.Members).TotalPower()
 			for _, val := range response.Valset.Members {
 				var addr transaction.Address
-				bytes, _ := wallet.AddressToHex(val.MinterAddress)
+				bytes, err := wallet.AddressToHex(val.MinterAddress)
+				if err != nil {
+					return &RelayError{Step: StepSigning, Err: fmt.Errorf("member address %q: %w", val.MinterAddress, err)}
+				}
 				copy(addr[:], bytes)
 
-				weight := uint32(sdk.NewUint(val.Power).MulUint64(1000).QuoUint64(totalPower).Uint64())
-
 				txData.Addresses = append(txData.Addresses, addr)
-				txData.Weights = append(txData.Weights, weight)
+				txData.Weights = append(txData.Weights, multisigWeight(val.Power, totalPower))
+			}
+
+			tx, err := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
+			if err != nil {
+				return &RelayError{Step: StepSigning, Err: err}
 			}
 
-			tx, _ := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
 			tx.SetPayload([]byte(strconv.Itoa(int(response.Valset.Nonce))))
-			signedTx, _ := tx.SetNonce(response.Valset.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti).Sign(
+			signedTx, err := tx.SetNonce(response.Valset.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti).Sign(
 				cfg.Minter.MultisigAddr,
 				ctx.MinterWallet.PrivateKey,
 			)
+			if err != nil {
+				return &RelayError{Step: StepSigning, Err: err}
+			}
 
 			sigData, err := signedTx.SingleSignatureData()
 			if err != nil {
-				panic(err)
+				return &RelayError{Step: StepSigning, Err: err}
 			}
 
 			msg := &types.MsgValsetConfirm{
@@ -299,114 +478,231 @@ func relayValsets(ctx context.Context) {
 				Signature:     sigData,
 			}
 
-			cosmos.SendCosmosTx([]sdk.Msg{msg}, ctx.OrcAddress, ctx.OrcPriv, ctx.CosmosConn, ctx.Logger, false)
+			if err := ctx.CosmosSubmitter.Send(ctx, []sdk.Msg{msg}, false); err != nil {
+				ctx.Logger.Error("Error while sending confirm", "err", err.Error())
+			} else {
+				metrics.ConfirmsSubmitted.WithLabelValues(metrics.ConfirmValset).Inc()
+			}
 		}
 	}
 
 	latestValsets, err := cosmosClient.LastValsetRequests(c.Background(), &types.QueryLastValsetRequestsRequest{})
 	if err != nil {
-		ctx.Logger.Error("Error on getting last valset requests", "err", err.Error())
-		return
+		return &RelayError{Step: StepHubQuery, Err: err}
+	}
+
+	lastValset, err := cosmosClient.LastValset(c.Background(), &types.QueryLastValsetRequest{})
+	if err != nil {
+		return &RelayError{Step: StepHubQuery, Err: err}
 	}
 
 	var oldestSignedValset *types.Valset
-	var oldestSignatures []*types.MsgValsetConfirm
+	var oldestSignatures []string
 
 	for _, valset := range latestValsets.Valsets {
 		sigs, err := cosmosClient.ValsetConfirmsByNonce(c.Background(), &types.QueryValsetConfirmsByNonceRequest{
 			Nonce: valset.Nonce,
 		})
 		if err != nil {
-			ctx.Logger.Error("Error while getting valset confirms", "err", err.Error())
-			return
+			return &RelayError{Step: StepHubQuery, Err: err}
+		}
+
+		if sigs.Size() == 0 {
+			continue
 		}
 
-		if sigs.Size() > 0 { // todo: check if we have enough votes
-			oldestSignedValset = valset
-			oldestSignatures = sigs.Confirms
+		var signatures []string
+		for _, sig := range sigs.Confirms {
+			signatures = append(signatures, sig.Signature)
+		}
+
+		// Initial multisig is not controlled by the hub valset, so there is nothing to count
+		if members := lastValset.GetValset().GetMembers(); len(members) > 0 {
+			signingData, err := valset.GetMinterSigningData(uint64(cfg.Minter.ChainID))
+			if err != nil {
+				return &RelayError{Step: StepSigning, Err: err}
+			}
+
+			signed := memberSignatures(lastValset.GetValset(), signingData, signatures)
+			weight, missing := collectedWeight(lastValset.GetValset(), signed)
+			if weight < types.MinterMultisigThreshold {
+				ctx.Logger.Info("Not enough signatures for valset", "valset nonce", valset.Nonce, "weight", weight, "missing", strings.Join(missing, ","))
+				continue
+			}
+
+			// Only the signatures of the members of the last confirmed valset are added
+			signatures = nil
+			for _, member := range members {
+				if signature, ok := signed[strings.ToLower(member.MinterAddress)]; ok {
+					signatures = append(signatures, signature)
+				}
+			}
 		}
+
+		oldestSignedValset = valset
+		oldestSignatures = signatures
 	}
 
 	if oldestSignedValset == nil {
-		return
+		return nil
 	}
 
-	if oldestSignedValset.Nonce < ctx.LastValsetNonce {
-		return
+	if oldestSignedValset.Nonce < ctx.State.Nonces().LastValsetNonce {
+		return nil
 	}
 
 	ctx.Logger.Info("Sending valset to Minter")
 
 	txData := transaction.NewEditMultisigData()
-	txData.Threshold = threshold
-
-	totalPower := uint64(0)
-	for _, val := range oldestSignedValset.Members {
-		totalPower += val.Power
-	}
+	txData.Threshold = types.MinterMultisigThreshold
 
+	totalPower := types.BridgeValidators(oldestSignedValset.Members).TotalPower()
 	for _, val := range oldestSignedValset.Members {
 		var addr transaction.Address
-		bytes, _ := wallet.AddressToHex(val.MinterAddress)
+		bytes, err := wallet.AddressToHex(val.MinterAddress)
+		if err != nil {
+			return &RelayError{Step: StepSigning, Err: fmt.Errorf("member address %q: %w", val.MinterAddress, err)}
+		}
 		copy(addr[:], bytes)
 
-		weight := uint32(sdk.NewUint(val.Power).MulUint64(1000).QuoUint64(totalPower).Uint64())
-
 		txData.Addresses = append(txData.Addresses, addr)
-		txData.Weights = append(txData.Weights, weight)
+		txData.Weights = append(txData.Weights, multisigWeight(val.Power, totalPower))
 	}
 
-	tx, _ := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
-	tx.SetNonce(oldestSignedValset.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti)
-	tx.SetPayload([]byte(strconv.Itoa(int(oldestSignedValset.Nonce))))
-	signedTx, err := tx.Sign(cfg.Minter.MultisigAddr)
+	tx, err := transaction.NewBuilder(cfg.Minter.ChainID).NewTransaction(txData)
 	if err != nil {
-		panic(err)
+		return &RelayError{Step: StepSigning, Err: err}
 	}
 
-	lastValset, err := cosmosClient.LastValset(c.Background(), &types.QueryLastValsetRequest{})
+	tx.SetNonce(oldestSignedValset.MinterNonce).SetGasPrice(1).SetGasCoin(0).SetSignatureType(transaction.SignatureTypeMulti)
+	tx.SetPayload([]byte(strconv.Itoa(int(oldestSignedValset.Nonce))))
+	signedTx, err := tx.Sign(cfg.Minter.MultisigAddr)
 	if err != nil {
-		panic(err)
+		return &RelayError{Step: StepSigning, Err: err}
 	}
 
-	// Check if signer is in the last confirmed valset
-	for _, sig := range oldestSignatures {
-		hasMember := false
-		for _, member := range lastValset.GetValset().GetMembers() {
-			if strings.ToLower(member.MinterAddress) == strings.ToLower(sig.MinterAddress) {
-				hasMember = true
-			}
-		}
-
-		if hasMember || len(lastValset.GetValset().GetMembers()) == 0 {
-			signedTx, err = signedTx.AddSignature(sig.Signature)
-			if err != nil {
-				panic(err)
-			}
+	for _, signature := range oldestSignatures {
+		signedTx, err = signedTx.AddSignature(signature)
+		if err != nil {
+			return &RelayError{Step: StepSigning, Err: err}
 		}
 	}
 
 	encodedTx, err := signedTx.Encode()
 	if err != nil {
-		panic(err)
+		return &RelayError{Step: StepSigning, Err: err}
 	}
 
 	ctx.Logger.Debug("Valset update tx", "tx", encodedTx)
+	if err := sendMinterTx(ctx, encodedTx); err != nil {
+		return err
+	}
+
+	metrics.ValsetsRelayed.Inc()
+	return nil
+}
+
+func sendMinterTx(ctx context.Context, encodedTx string) error {
 	response, err := ctx.MinterClient.SendTransaction(encodedTx)
 	if err != nil {
-		code, body, err := http_client.ErrorBody(err)
+		metrics.MinterTxErrors.Inc()
+
+		code, body, bodyErr := http_client.ErrorBody(err)
+		if bodyErr != nil {
+			return &RelayError{Step: StepMinterTx, Err: err}
+		}
+
+		return &RelayError{Step: StepMinterTx, Err: fmt.Errorf("code %d: %s", code, body.Error.Message)}
+	}
+
+	if response.Code != 0 {
+		metrics.MinterTxErrors.Inc()
+		return &RelayError{Step: StepMinterTx, Err: errors.New(response.Log)}
+	}
+
+	return nil
+}
+
+// multisigWeight returns the weight of a valset member in the Minter multisig
+func multisigWeight(power uint64, totalPower uint64) uint32 {
+	return uint32(sdk.NewUint(power).MulUint64(types.MinterMultisigTotalWeight).QuoUint64(totalPower).Uint64())
+}
+
+// memberSignatures maps the signatures of the confirms to the lowercase Minter addresses of the valset members who made
+// them. The signer is recovered from the signature over the Minter tx, the address declared in a confirm is not
+// trusted, and every member is counted once no matter how many orchestrators sent its signature.
+func memberSignatures(valset *types.Valset, signingData []byte, signatures []string) map[string]string {
+	members := map[string]bool{}
+	for _, member := range valset.GetMembers() {
+		members[strings.ToLower(member.MinterAddress)] = true
+	}
+
+	hash := ethCrypto.Keccak256(signingData)
+	signed := map[string]string{}
+	for _, signature := range signatures {
+		sigBytes, err := types.DecodeMinterSignature(signature)
 		if err != nil {
-			ctx.Logger.Error("Error on sending Minter Tx", "err", err.Error())
+			continue
+		}
+
+		pubKey, err := ethCrypto.SigToPub(hash, sigBytes)
+		if err != nil {
+			continue
+		}
+
+		signer := strings.ToLower("Mx" + ethCrypto.PubkeyToAddress(*pubKey).Hex()[2:])
+		if _, ok := signed[signer]; members[signer] && !ok {
+			signed[signer] = signature
+		}
+	}
+
+	return signed
+}
+
+// collectedWeight sums the multisig weights of the valset members who signed and lists the ones who didn't
+func collectedWeight(valset *types.Valset, signed map[string]string) (weight uint32, missing []string) {
+	totalPower := types.BridgeValidators(valset.GetMembers()).TotalPower()
+	for _, member := range valset.GetMembers() {
+		if _, ok := signed[strings.ToLower(member.MinterAddress)]; ok {
+			weight += multisigWeight(member.Power, totalPower)
 		} else {
-			ctx.Logger.Error("Error on sending Minter Tx", "code", code, "err", body.Error.Message)
+			missing = append(missing, member.MinterAddress)
 		}
-	} else if response.Code != 0 {
-		ctx.Logger.Error("Error on sending Minter Tx", "err", response.Log)
 	}
+
+	return weight, missing
 }
 
-func relayMinterEvents(ctx context.Context) context.Context {
-	latestBlock := minter.GetLatestMinterBlock(ctx.MinterClient, ctx.Logger)
+// observeNonces exports the progress of the connector and of its orchestrator in the hub
+func observeNonces(ctx context.Context) {
+	metrics.LastCheckedMinterBlock.Set(float64(ctx.LastCheckedMinterBlock))
+	metrics.LocalEventNonce.Set(float64(ctx.LastEventNonce))
+
+	hubNonce, err := cosmos.GetLastMinterNonce(ctx.OrcAddress.String(), ctx.CosmosConn)
+	if err != nil {
+		ctx.Logger.Error("Error while getting hub event nonce", "err", err.Error())
+		return
+	}
+
+	metrics.HubEventNonce.Set(float64(hubNonce))
+}
+
+func relayMinterEvents(ctx context.Context) (context.Context, error) {
+	latestBlock, err := minter.GetLatestMinterBlock(ctx, ctx.MinterClient, ctx.Logger)
+	if err != nil {
+		return ctx, &RelayError{Step: StepMinterQuery, Err: err}
+	}
+
+	if latestBlock >= ctx.LastCheckedMinterBlock {
+		metrics.MinterHeadLag.Set(float64(latestBlock - ctx.LastCheckedMinterBlock))
+	}
+
+	// only blocks with enough confirmations are relayed
+	if latestBlock < ctx.LastCheckedMinterBlock+cfg.Minter.Confirmations+1 {
+		return ctx, nil
+	}
+	latestBlock -= cfg.Minter.Confirmations
+
 	if latestBlock-ctx.LastCheckedMinterBlock > 100 {
 		latestBlock = ctx.LastCheckedMinterBlock + 100
 	}
@@ -414,15 +710,15 @@ func relayMinterEvents(ctx context.Context) context.Context {
 	oracleClient := oracleTypes.NewQueryClient(ctx.CosmosConn)
 	coinList, err := oracleClient.Coins(c.Background(), &oracleTypes.QueryCoinsRequest{})
 	if err != nil {
-		ctx.Logger.Info("Error getting coins from hub", "err", err.Error())
-		time.Sleep(time.Second)
-		return ctx
+		return ctx, &RelayError{Step: StepHubQuery, Err: err}
 	}
 
 	var deposits []cosmos.Deposit
 	var batches []cosmos.Batch
 	var valsets []cosmos.Valset
 
+	eventHashes := map[uint64][]string{}
+
 	const blocksPerBatch = 100
 	for i := uint64(0); i <= uint64(math.Ceil(float64(latestBlock-ctx.LastCheckedMinterBlock)/blocksPerBatch)); i++ {
 		from := ctx.LastCheckedMinterBlock + 1 + i*blocksPerBatch
@@ -432,12 +728,9 @@ func relayMinterEvents(ctx context.Context) context.Context {
 			to = latestBlock
 		}
 
-		blocks, err := ctx.MinterClient.Blocks(from, to, false)
+		blocks, err := minter.GetBlocks(ctx, ctx.MinterClient, ctx.Logger, from, to)
 		if err != nil {
-			ctx.Logger.Info("Error getting minter blocks", "err", err.Error())
-			time.Sleep(time.Second)
-			i--
-			continue
+			return ctx, &RelayError{Step: StepMinterQuery, Err: err}
 		}
 
 		for _, block := range blocks.Blocks {
@@ -478,6 +771,7 @@ func relayMinterEvents(ctx context.Context) context.Context {
 								CoinID:     sendData.Coin.ID,
 								TxHash:     tx.Hash,
 							})
+							eventHashes[block.Height] = append(eventHashes[block.Height], tx.Hash)
 
 							ctx.LastEventNonce++
 						}
@@ -485,40 +779,70 @@ func relayMinterEvents(ctx context.Context) context.Context {
 				}
 
 				if tx.Type == uint64(transaction.TypeMultisend) && tx.From == cfg.Minter.MultisigAddr {
-					ctx.Logger.Info("Found withdrawal")
+					nonce, err := minter.ParseBatchPayload(tx.Payload, ctx.LastBatchNonce)
+					if err != nil {
+						ctx.Logger.Error("Unrecognized multisend from multisig, skipping", "hash", tx.Hash, "err", err.Error())
+						continue
+					}
+
+					ctx.Logger.Info("Found withdrawal", "batch nonce", nonce)
 					batches = append(batches, cosmos.Batch{
-						BatchNonce: ctx.LastBatchNonce,
+						BatchNonce: nonce,
 						EventNonce: ctx.LastEventNonce,
 						TxHash:     tx.Hash,
 					})
+					eventHashes[block.Height] = append(eventHashes[block.Height], tx.Hash)
 
 					ctx.LastEventNonce++
-					ctx.LastBatchNonce++
+					ctx.LastBatchNonce = nonce
 				}
 
 				if tx.Type == uint64(transaction.TypeEditMultisig) && tx.From == cfg.Minter.MultisigAddr {
 					ctx.Logger.Info("Found valset update")
 
-					nonce, err := strconv.Atoi(string(tx.Payload))
+					nonce, err := minter.ParseNoncePayload(tx.Payload)
 					if err != nil {
 						ctx.Logger.Error("Error while decoding valset update nonce", "err", err.Error())
 					} else {
 						valsets = append(valsets, cosmos.Valset{
-							ValsetNonce: uint64(nonce),
+							ValsetNonce: nonce,
 							EventNonce:  ctx.LastEventNonce,
 						})
+						eventHashes[block.Height] = append(eventHashes[block.Height], tx.Hash)
 
 						ctx.LastEventNonce++
-						ctx.LastValsetNonce = uint64(nonce)
+						ctx.LastValsetNonce = nonce
 					}
 				}
 			}
 		}
 	}
 
+	// claims are irreversible, so every relayed event is checked against the block fetched once again
+	for height, hashes := range eventHashes {
+		if err := minter.VerifyTxs(ctx, ctx.MinterVerifyClient, ctx.Logger, height, hashes); err != nil {
+			return ctx, &RelayError{Step: StepMinterQuery, Err: err}
+		}
+	}
+
 	if len(deposits) > 0 || len(batches) > 0 || len(valsets) > 0 {
-		cosmos.SendCosmosTx(cosmos.CreateClaims(ctx.CosmosConn, ctx.OrcAddress, deposits, batches, valsets, ctx.Logger), ctx.OrcAddress, ctx.OrcPriv, ctx.CosmosConn, ctx.Logger, true)
+		claims, err := cosmos.CreateClaims(ctx.CosmosConn, ctx.OrcAddress, deposits, batches, valsets, ctx.Logger)
+		if err != nil {
+			return ctx, &RelayError{Step: StepHubQuery, Err: err}
+		}
+
+		// a failed pass is scanned again, the claims which got in before the failure are not resent
+		hubNonce, err := cosmos.GetLastMinterNonce(ctx.OrcAddress.String(), ctx.CosmosConn)
+		if err != nil {
+			return ctx, &RelayError{Step: StepHubQuery, Err: err}
+		}
+
+		if err := ctx.CosmosSubmitter.Send(ctx, cosmos.SkipRelayedClaims(claims, hubNonce), true); err != nil {
+			return ctx, &RelayError{Step: StepCosmosTx, Err: err}
+		}
+
+		metrics.DepositsRelayed.Add(float64(len(deposits)))
 	}
 
-	return ctx
+	return ctx, nil
 }
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"flag"
 	"reflect"
+	"time"
 
 	"github.com/MinterTeam/minter-go-sdk/v2/transaction"
 	"github.com/mitchellh/mapstructure"
@@ -15,11 +16,13 @@ type MinterConfig struct {
 	MultisigAddr     string              `mapstructure:"multisig_addr"`
 	ChainID          transaction.ChainID `mapstructure:"chain"`
 	ApiAddr          string              `mapstructure:"api_addr"`
+	VerifyApiAddr    string              `mapstructure:"verify_api_addr"`
 	Mnemonic         string
 	StartBlock       uint64 `mapstructure:"start_block"`
 	StartEventNonce  uint64 `mapstructure:"start_event_nonce"`
 	StartBatchNonce  uint64 `mapstructure:"start_batch_nonce"`
 	StartValsetNonce uint64 `mapstructure:"start_valset_nonce"`
+	Confirmations    uint64
 }
 
 type CosmosConfig struct {
@@ -28,9 +31,27 @@ type CosmosConfig struct {
 	RpcAddr  string `mapstructure:"rpc_addr"`
 }
 
+type StorageConfig struct {
+	DataDir string `mapstructure:"data_dir"`
+}
+
+type RelayConfig struct {
+	BatchesInterval time.Duration `mapstructure:"batches_interval"`
+	ValsetsInterval time.Duration `mapstructure:"valsets_interval"`
+	EventsInterval  time.Duration `mapstructure:"events_interval"`
+}
+
+type MetricsConfig struct {
+	Addr             string
+	ProgressInterval time.Duration `mapstructure:"progress_interval"`
+}
+
 type Config struct {
-	Minter MinterConfig
-	Cosmos CosmosConfig
+	Minter  MinterConfig
+	Cosmos  CosmosConfig
+	Storage StorageConfig
+	Relay   RelayConfig
+	Metrics MetricsConfig
 }
 
 var cfg *Config
@@ -45,14 +66,20 @@ func Get() *Config {
 
 	v := viper.New()
 	v.SetConfigFile(*configPath)
+	v.SetDefault("storage.data_dir", "data")
+	v.SetDefault("relay.batches_interval", "2s")
+	v.SetDefault("relay.valsets_interval", "2s")
+	v.SetDefault("relay.events_interval", "2s")
+	v.SetDefault("metrics.progress_interval", "5m")
 
 	if err := v.ReadInConfig(); err != nil {
 		panic(err)
 	}
 
-	err := v.Unmarshal(&cfg, viper.DecodeHook(
+	err := v.Unmarshal(&cfg, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
 		stringToChainHook(),
-	))
+		mapstructure.StringToTimeDurationHookFunc(),
+	)))
 
 	if err != nil {
 		panic(err)
@@ -1,6 +1,9 @@
 package context
 
 import (
+	c "context"
+	"sync"
+
 	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client"
 	"github.com/MinterTeam/minter-go-sdk/v2/wallet"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
@@ -9,7 +12,10 @@ import (
 	"google.golang.org/grpc"
 )
 
+// Context holds the state of the connector. It embeds the process context, which is cancelled on shutdown.
 type Context struct {
+	c.Context
+
 	LastCheckedMinterBlock uint64
 	LastEventNonce         uint64
 	LastBatchNonce         uint64
@@ -17,11 +23,61 @@ type Context struct {
 
 	MinterMultisigAddr string
 
-	CosmosConn   *grpc.ClientConn
-	MinterClient *http_client.Client
+	// State is shared between the relay goroutines
+	State *State
+
+	CosmosConn         *grpc.ClientConn
+	CosmosSubmitter    TxSubmitter
+	MinterClient       *http_client.Client
+	MinterVerifyClient *http_client.Client
 
 	OrcAddress   sdk.AccAddress
 	OrcPriv      *secp256k1.PrivKey
 	MinterWallet *wallet.Wallet
 	Logger       log.Logger
 }
+
+// TxSubmitter sends txs to the hub
+type TxSubmitter interface {
+	Send(ctx c.Context, msgs []sdk.Msg, retry bool) error
+}
+
+// Nonces is the progress of the connector in Minter blockchain
+type Nonces struct {
+	LastCheckedMinterBlock uint64
+	LastEventNonce         uint64
+	LastBatchNonce         uint64
+	LastValsetNonce        uint64
+}
+
+// Nonces returns the progress stored in the context
+func (ctx Context) Nonces() Nonces {
+	return Nonces{
+		LastCheckedMinterBlock: ctx.LastCheckedMinterBlock,
+		LastEventNonce:         ctx.LastEventNonce,
+		LastBatchNonce:         ctx.LastBatchNonce,
+		LastValsetNonce:        ctx.LastValsetNonce,
+	}
+}
+
+// State holds the progress published by the Minter events relay, so that other relays can read it
+type State struct {
+	mu     sync.RWMutex
+	nonces Nonces
+}
+
+// Nonces returns the last published progress
+func (s *State) Nonces() Nonces {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.nonces
+}
+
+// SetNonces publishes the progress
+func (s *State) SetNonces(nonces Nonces) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.nonces = nonces
+}
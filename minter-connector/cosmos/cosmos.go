@@ -2,12 +2,15 @@ package cosmos
 
 import (
 	"context"
+	"fmt"
 	"github.com/MinterTeam/mhub/chain/app"
 	mhub "github.com/MinterTeam/mhub/chain/x/minter/types"
 	oracleTypes "github.com/MinterTeam/mhub/chain/x/oracle/types"
 	phub "github.com/MinterTeam/mhub/chain/x/peggy/types"
 	"github.com/MinterTeam/minter-hub-connector/command"
 	"github.com/MinterTeam/minter-hub-connector/config"
+	"github.com/MinterTeam/minter-hub-connector/helpers"
+	"github.com/MinterTeam/minter-hub-connector/metrics"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	crypto "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -22,11 +25,11 @@ import (
 	"google.golang.org/grpc"
 	"sort"
 	"strings"
+	"sync"
 	"time"
 )
 
 var encoding = app.MakeEncodingConfig()
-var cfg = config.Get()
 
 type Batch struct {
 	BatchNonce uint64
@@ -56,13 +59,11 @@ func Setup() {
 	config.Seal()
 }
 
-func CreateClaims(cosmosConn *grpc.ClientConn, orcAddress sdk.AccAddress, deposits []Deposit, batches []Batch, valsets []Valset, logger log.Logger) []sdk.Msg {
+func CreateClaims(cosmosConn *grpc.ClientConn, orcAddress sdk.AccAddress, deposits []Deposit, batches []Batch, valsets []Valset, logger log.Logger) ([]sdk.Msg, error) {
 	oracleClient := oracleTypes.NewQueryClient(cosmosConn)
 	coinList, err := oracleClient.Coins(context.Background(), &oracleTypes.QueryCoinsRequest{})
 	if err != nil {
-		logger.Error("Error getting hub coins", "err", err.Error())
-		time.Sleep(time.Second)
-		return CreateClaims(cosmosConn, orcAddress, deposits, batches, valsets, logger)
+		return nil, fmt.Errorf("getting hub coins: %w", err)
 	}
 
 	coins := oracleTypes.NewCoins(coinList.GetCoins())
@@ -74,7 +75,7 @@ func CreateClaims(cosmosConn *grpc.ClientConn, orcAddress sdk.AccAddress, deposi
 
 		denom, err := coins.GetDenomByMinterId(deposit.CoinID)
 		if err != nil {
-			panic(err)
+			return nil, fmt.Errorf("deposit %s: %w", deposit.TxHash, err)
 		}
 
 		if deposit.Type == command.TypeSendToEth {
@@ -125,7 +126,35 @@ func CreateClaims(cosmosConn *grpc.ClientConn, orcAddress sdk.AccAddress, deposi
 		return getEventNonceFromMsg(msgs[i]) < getEventNonceFromMsg(msgs[j])
 	})
 
-	return msgs
+	return msgs, nil
+}
+
+// SkipRelayedClaims drops the claims the hub has already seen from us, lastEventNonce is the last event nonce of our
+// orchestrator on the hub. A failed send may have delivered some of the claims, the events are scanned again after
+// the failure and only the missing claims are resent. The other msgs are dropped when no claim is left to send.
+func SkipRelayedClaims(msgs []sdk.Msg, lastEventNonce uint64) []sdk.Msg {
+	type claim interface {
+		GetEventNonce() uint64
+	}
+
+	var left []sdk.Msg
+	claims := 0
+	for _, msg := range msgs {
+		if c, ok := msg.(claim); ok {
+			if c.GetEventNonce() <= lastEventNonce {
+				continue
+			}
+			claims++
+		}
+
+		left = append(left, msg)
+	}
+
+	if claims == 0 {
+		return nil
+	}
+
+	return left
 }
 
 func getEventNonceFromMsg(msg sdk.Msg) uint64 {
@@ -143,21 +172,101 @@ func getEventNonceFromMsg(msg sdk.Msg) uint64 {
 	return 999999999
 }
 
-func SendCosmosTx(msgs []sdk.Msg, address sdk.AccAddress, priv crypto.PrivKey, cosmosConn *grpc.ClientConn, logger log.Logger, retry bool) {
-	if len(msgs) > 10 {
-		SendCosmosTx(msgs[:10], address, priv, cosmosConn, logger, retry)
-		SendCosmosTx(msgs[10:], address, priv, cosmosConn, logger, retry)
-		return
+// Submitter is the only sender of the hub txs. It serializes the broadcasts and tracks the account sequence, so
+// the txs of different relays never race on it.
+type Submitter struct {
+	mu sync.Mutex
+
+	address    sdk.AccAddress
+	priv       crypto.PrivKey
+	cosmosConn *grpc.ClientConn
+	logger     log.Logger
+
+	number   uint64
+	sequence uint64
+	loaded   bool
+}
+
+// NewSubmitter returns Submitter which signs txs with priv
+func NewSubmitter(address sdk.AccAddress, priv crypto.PrivKey, cosmosConn *grpc.ClientConn, logger log.Logger) *Submitter {
+	return &Submitter{
+		address:    address,
+		priv:       priv,
+		cosmosConn: cosmosConn,
+		logger:     logger,
 	}
+}
+
+// Send signs and broadcasts msgs to the hub. If retry is set, failed txs are resent with a bounded exponential
+// backoff until ctx is done. The lock is held for a single broadcast only, so the txs of the other relays are not
+// blocked while a failed tx waits for its next attempt.
+func (s *Submitter) Send(ctx context.Context, msgs []sdk.Msg, retry bool) error {
+	for len(msgs) > 0 {
+		chunk := msgs
+		if len(chunk) > 10 {
+			chunk = msgs[:10]
+		}
+		msgs = msgs[len(chunk):]
+
+		attempt := 0
+		send := func() error {
+			attempt++
+			if attempt > 1 {
+				metrics.CosmosTxRetries.Inc()
+			}
 
-	number, sequence := getAccount(address.String(), cosmosConn, logger)
+			s.mu.Lock()
+			err := s.send(ctx, chunk)
+			s.mu.Unlock()
+
+			if err != nil {
+				metrics.CosmosTxFailures.Inc()
+			}
+
+			return err
+		}
 
+		var err error
+		if retry {
+			err = helpers.DefaultBackoff.Retry(ctx, send)
+		} else {
+			err = send()
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// send broadcasts a single tx. The account sequence is reloaded from the hub after a failure.
+func (s *Submitter) send(ctx context.Context, msgs []sdk.Msg) error {
+	if !s.loaded {
+		number, sequence, err := getAccount(ctx, s.address.String(), s.cosmosConn, s.logger)
+		if err != nil {
+			return err
+		}
+
+		s.number, s.sequence, s.loaded = number, sequence, true
+	}
+
+	if err := sendCosmosTx(msgs, s.number, s.sequence, s.priv, s.logger); err != nil {
+		s.loaded = false
+		return err
+	}
+
+	s.sequence++
+	return nil
+}
+
+func sendCosmosTx(msgs []sdk.Msg, number, sequence uint64, priv crypto.PrivKey, logger log.Logger) error {
 	fee := sdk.NewCoins(sdk.NewCoin("hub", sdk.NewInt(1)))
 
 	tx := encoding.TxConfig.NewTxBuilder()
-	err := tx.SetMsgs(msgs...)
-	if err != nil {
-		panic(err)
+	if err := tx.SetMsgs(msgs...); err != nil {
+		return fmt.Errorf("setting tx msgs: %w", err)
 	}
 
 	tx.SetMemo("")
@@ -175,17 +284,17 @@ func SendCosmosTx(msgs []sdk.Msg, address sdk.AccAddress, priv crypto.PrivKey, c
 	}
 
 	if err := tx.SetSignatures(sig); err != nil {
-		panic(err)
+		return fmt.Errorf("setting tx signatures: %w", err)
 	}
 
-	client, err := tmClient.New(cfg.Cosmos.RpcAddr, "")
+	client, err := tmClient.New(config.Get().Cosmos.RpcAddr, "")
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("creating rpc client: %w", err)
 	}
 
 	status, err := client.Status(context.TODO())
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("getting node status: %w", err)
 	}
 
 	signBytes, err := encoding.TxConfig.SignModeHandler().GetSignBytes(signing.SignMode_SIGN_MODE_DIRECT, signing2.SignerData{
@@ -194,13 +303,13 @@ func SendCosmosTx(msgs []sdk.Msg, address sdk.AccAddress, priv crypto.PrivKey, c
 		Sequence:      sequence,
 	}, tx.GetTx())
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("getting sign bytes: %w", err)
 	}
 
 	// Sign those bytes
 	sigBytes, err := priv.Sign(signBytes)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("signing tx: %w", err)
 	}
 
 	// Construct the SignatureV2 struct
@@ -215,12 +324,12 @@ func SendCosmosTx(msgs []sdk.Msg, address sdk.AccAddress, priv crypto.PrivKey, c
 	}
 
 	if err := tx.SetSignatures(sig); err != nil {
-		panic(err)
+		return fmt.Errorf("setting tx signatures: %w", err)
 	}
 
 	txBytes, err := encoding.TxConfig.TxEncoder()(tx.GetTx())
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("encoding tx: %w", err)
 	}
 
 	result, err := client.BroadcastTxCommit(context.Background(), txBytes)
@@ -230,56 +339,59 @@ func SendCosmosTx(msgs []sdk.Msg, address sdk.AccAddress, priv crypto.PrivKey, c
 		}
 
 		time.Sleep(5 * time.Second)
-		txResponse, err := client.Tx(context.Background(), tmTypes.Tx(txBytes).Hash(), false)
-		if (err != nil || txResponse.TxResult.IsErr()) && retry {
-			SendCosmosTx(msgs, address, priv, cosmosConn, logger, retry)
+		txResponse, txErr := client.Tx(context.Background(), tmTypes.Tx(txBytes).Hash(), false)
+		if txErr == nil && !txResponse.TxResult.IsErr() {
+			return nil
 		}
 
-		return
+		return fmt.Errorf("broadcasting tx: %w", err)
 	}
 
 	if result.DeliverTx.GetCode() != 0 || result.CheckTx.GetCode() != 0 {
 		logger.Error("Error on sending cosmos tx with", "code", result.CheckTx.GetCode(), "log", result.DeliverTx.GetLog())
-		if retry {
-			time.Sleep(1 * time.Second)
-			SendCosmosTx(msgs, address, priv, cosmosConn, logger, retry)
-		}
-
-		return
+		return fmt.Errorf("tx failed with code %d/%d", result.CheckTx.GetCode(), result.DeliverTx.GetCode())
 	}
 
 	logger.Info("Sending cosmos tx", "code", result.DeliverTx.GetCode(), "log", result.DeliverTx.GetLog(), "info", result.DeliverTx.GetInfo())
+	return nil
 }
 
-func GetLastMinterNonce(address string, conn *grpc.ClientConn) uint64 {
+func GetLastMinterNonce(address string, conn *grpc.ClientConn) (uint64, error) {
 	client := mhub.NewQueryClient(conn)
 
 	result, err := client.LastEventNonceByAddr(context.Background(), &mhub.QueryLastEventNonceByAddrRequest{Address: address})
 	if err != nil {
-		panic(err)
+		return 0, fmt.Errorf("getting last event nonce: %w", err)
 	}
 
-	return result.EventNonce
+	return result.EventNonce, nil
 }
 
-func getAccount(address string, conn *grpc.ClientConn, logger log.Logger) (number, sequence uint64) {
+func getAccount(ctx context.Context, address string, conn *grpc.ClientConn, logger log.Logger) (number, sequence uint64, err error) {
 	authClient := types.NewQueryClient(conn)
 
-	response, err := authClient.Account(context.Background(), &types.QueryAccountRequest{Address: address})
-	if err != nil {
-		logger.Error("Error getting cosmos account", "err", err.Error())
-		time.Sleep(1 * time.Second)
-		return getAccount(address, conn, logger)
-	}
+	err = helpers.DefaultBackoff.Retry(ctx, func() error {
+		response, err := authClient.Account(context.Background(), &types.QueryAccountRequest{Address: address})
+		if err != nil {
+			logger.Error("Error getting cosmos account", "err", err.Error())
+			return err
+		}
+
+		var account types.AccountI
+		if err := encoding.Marshaler.UnpackAny(response.Account, &account); err != nil {
+			logger.Error("Error unpacking cosmos account", "err", err.Error())
+			return err
+		}
 
-	var account types.AccountI
-	if err := encoding.Marshaler.UnpackAny(response.Account, &account); err != nil {
-		logger.Error("Error unpacking cosmos account", "err", err.Error())
-		time.Sleep(1 * time.Second)
-		return getAccount(address, conn, logger)
+		number, sequence = account.GetAccountNumber(), account.GetSequence()
+		return nil
+	})
+
+	if err != nil {
+		return 0, 0, fmt.Errorf("getting cosmos account: %w", err)
 	}
 
-	return account.GetAccountNumber(), account.GetSequence()
+	return number, sequence, nil
 }
 
 func GetAccount(mnemonic string) (sdk.AccAddress, *secp256k1.PrivKey) {
@@ -0,0 +1,42 @@
+package cosmos
+
+import (
+	"testing"
+
+	mhub "github.com/MinterTeam/mhub/chain/x/minter/types"
+	phub "github.com/MinterTeam/mhub/chain/x/peggy/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestSkipRelayedClaims(t *testing.T) {
+	var claims []sdk.Msg
+	for nonce := uint64(1); nonce <= 15; nonce++ {
+		claims = append(claims, &mhub.MsgDepositClaim{EventNonce: nonce})
+	}
+	claims = append(claims, &phub.MsgRequestBatch{Denom: "usdc"})
+
+	// the first chunk of 10 claims got in, the second one failed
+	left := SkipRelayedClaims(claims, 10)
+	if len(left) != 6 {
+		t.Fatalf("expected 5 claims and the batch request, got %d msgs", len(left))
+	}
+
+	for i, msg := range left[:5] {
+		if nonce := msg.(*mhub.MsgDepositClaim).EventNonce; nonce != uint64(11+i) {
+			t.Fatalf("expected event nonce %d, got %d", 11+i, nonce)
+		}
+	}
+
+	if _, ok := left[5].(*phub.MsgRequestBatch); !ok {
+		t.Fatalf("expected the batch request to be kept, got %T", left[5])
+	}
+
+	// the second chunk got in as well, though its broadcast has failed
+	if left := SkipRelayedClaims(claims, 15); len(left) != 0 {
+		t.Fatalf("expected nothing to resend, got %d msgs", len(left))
+	}
+
+	if left := SkipRelayedClaims(claims, 0); len(left) != len(claims) {
+		t.Fatalf("expected all the msgs to be sent, got %d", len(left))
+	}
+}
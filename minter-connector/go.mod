@@ -13,7 +13,9 @@ require (
 	github.com/cosmos/go-bip39 v1.0.0
 	github.com/ethereum/go-ethereum v1.9.22
 	github.com/mitchellh/mapstructure v1.3.3 // indirect
+	github.com/prometheus/client_golang v1.8.0
 	github.com/spf13/viper v1.7.1
 	github.com/tendermint/tendermint v0.34.9
+	github.com/tendermint/tm-db v0.6.4
 	google.golang.org/grpc v1.35.0
 )
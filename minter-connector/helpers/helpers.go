@@ -1 +1,46 @@
 package helpers
+
+import (
+	"context"
+	"time"
+)
+
+// Backoff is a bounded exponential backoff policy
+type Backoff struct {
+	Initial  time.Duration
+	Max      time.Duration
+	Attempts int
+}
+
+// DefaultBackoff is used for the requests to Minter and to the hub
+var DefaultBackoff = Backoff{
+	Initial:  time.Second,
+	Max:      30 * time.Second,
+	Attempts: 8,
+}
+
+// Retry calls fn until it succeeds, attempts are exhausted or ctx is done. The last error of fn is returned.
+func (b Backoff) Retry(ctx context.Context, fn func() error) error {
+	delay := b.Initial
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= b.Attempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+
+		delay *= 2
+		if delay > b.Max {
+			delay = b.Max
+		}
+	}
+}
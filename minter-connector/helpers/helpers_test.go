@@ -0,0 +1,45 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetry(t *testing.T) {
+	b := Backoff{Initial: time.Millisecond, Max: 2 * time.Millisecond, Attempts: 3}
+
+	calls := 0
+	err := b.Retry(context.Background(), func() error {
+		calls++
+		if calls < 2 {
+			return errors.New("fail")
+		}
+		return nil
+	})
+	if err != nil || calls != 2 {
+		t.Fatalf("Expected success after 2 calls, got %d calls and err %v", calls, err)
+	}
+
+	calls = 0
+	err = b.Retry(context.Background(), func() error {
+		calls++
+		return errors.New("fail")
+	})
+	if err == nil || calls != 3 {
+		t.Fatalf("Expected error after 3 calls, got %d calls and err %v", calls, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls = 0
+	err = b.Retry(ctx, func() error {
+		calls++
+		return errors.New("fail")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("Expected error after 1 call on done context, got %d calls and err %v", calls, err)
+	}
+}
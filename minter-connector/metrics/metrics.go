@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "mhub_minter_connector"
+
+var (
+	LastCheckedMinterBlock = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "last_checked_minter_block",
+		Help:      "Height of the last Minter block scanned by the connector",
+	})
+
+	MinterHeadLag = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "minter_head_lag_blocks",
+		Help:      "Number of Minter blocks between the chain head and the last checked block",
+	})
+
+	LocalEventNonce = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "local_event_nonce",
+		Help:      "Next event nonce the connector is going to submit",
+	})
+
+	HubEventNonce = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "hub_event_nonce",
+		Help:      "Last event nonce of the orchestrator stored in the hub",
+	})
+
+	DepositsRelayed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "deposits_relayed_total",
+		Help:      "Number of Minter deposits claimed in the hub",
+	})
+
+	BatchesRelayed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "batches_relayed_total",
+		Help:      "Number of batches sent to Minter",
+	})
+
+	ValsetsRelayed = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "valsets_relayed_total",
+		Help:      "Number of valset updates sent to Minter",
+	})
+
+	ConfirmsSubmitted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "confirms_submitted_total",
+		Help:      "Number of batch and valset confirms submitted to the hub",
+	}, []string{"type"})
+
+	MinterTxErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "minter_tx_errors_total",
+		Help:      "Number of failed Minter transaction sends",
+	})
+
+	CosmosTxFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cosmos_tx_failures_total",
+		Help:      "Number of failed hub transaction broadcasts",
+	})
+
+	CosmosTxRetries = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cosmos_tx_retries_total",
+		Help:      "Number of hub transaction broadcasts retried after a failure",
+	})
+)
+
+// Confirm types
+const (
+	ConfirmBatch  = "batch"
+	ConfirmValset = "valset"
+)
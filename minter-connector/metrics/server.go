@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// Health reports whether the connector has made progress recently
+type Health struct {
+	mu           sync.Mutex
+	lastProgress time.Time
+	interval     time.Duration
+}
+
+// NewHealth returns Health which fails if no progress was made within interval
+func NewHealth(interval time.Duration) *Health {
+	return &Health{
+		lastProgress: time.Now(),
+		interval:     interval,
+	}
+}
+
+// MarkProgress records that the connector has finished a relay iteration
+func (h *Health) MarkProgress() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.lastProgress = time.Now()
+}
+
+// Check returns an error if the last progress is older than the configured interval
+func (h *Health) Check() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if since := time.Since(h.lastProgress); since > h.interval {
+		return fmt.Errorf("no progress for %s", since.Round(time.Second))
+	}
+
+	return nil
+}
+
+func (h *Health) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	if err := h.Check(); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+
+	_, _ = w.Write([]byte("ok"))
+}
+
+// Serve exposes /metrics and /healthz on addr until ctx is done
+func Serve(ctx context.Context, addr string, health *Health, logger log.Logger) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.Handler())
+	mux.Handle("/healthz", health)
+
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		<-ctx.Done()
+		_ = server.Close()
+	}()
+
+	logger.Info("Starting metrics server", "addr", addr)
+	if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		logger.Error("Metrics server stopped", "err", err.Error())
+	}
+}
@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestHealth(t *testing.T) {
+	health := NewHealth(time.Minute)
+
+	rec := httptest.NewRecorder()
+	health.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected healthy, got %d", rec.Code)
+	}
+
+	health.lastProgress = time.Now().Add(-2 * time.Minute)
+
+	rec = httptest.NewRecorder()
+	health.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("expected unhealthy, got %d", rec.Code)
+	}
+
+	health.MarkProgress()
+	if err := health.Check(); err != nil {
+		t.Fatal(err)
+	}
+}
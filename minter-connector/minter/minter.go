@@ -3,39 +3,51 @@ package minter
 import (
 	c "context"
 	"encoding/json"
+	"fmt"
 	oracleTypes "github.com/MinterTeam/mhub/chain/x/oracle/types"
 	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client"
 	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client/models"
 	"github.com/MinterTeam/minter-go-sdk/v2/transaction"
 	"github.com/MinterTeam/minter-hub-connector/command"
 	"github.com/MinterTeam/minter-hub-connector/context"
+	"github.com/MinterTeam/minter-hub-connector/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 	"math"
 	"strconv"
-	"time"
 )
 
-func GetLatestMinterBlock(client *http_client.Client, logger log.Logger) uint64 {
-	status, err := client.Status()
+func GetLatestMinterBlock(ctx c.Context, client *http_client.Client, logger log.Logger) (uint64, error) {
+	var height uint64
+	err := helpers.DefaultBackoff.Retry(ctx, func() error {
+		status, err := client.Status()
+		if err != nil {
+			logger.Error("Cannot get Minter status", "err", err.Error())
+			return err
+		}
+
+		height = status.LatestBlockHeight
+		return nil
+	})
 	if err != nil {
-		logger.Error("Cannot get Minter status", "err", err.Error())
-		time.Sleep(1 * time.Second)
-		return GetLatestMinterBlock(client, logger)
+		return 0, fmt.Errorf("getting Minter status: %w", err)
 	}
 
-	return status.LatestBlockHeight
+	return height, nil
 }
 
-func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) context.Context {
+func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) (context.Context, error) {
 	ctx.Logger.Info("Current nonce @ hub", "nonce", currentNonce)
 
-	latestBlock := GetLatestMinterBlock(ctx.MinterClient, ctx.Logger)
+	latestBlock, err := GetLatestMinterBlock(ctx, ctx.MinterClient, ctx.Logger)
+	if err != nil {
+		return ctx, err
+	}
 
 	oracleClient := oracleTypes.NewQueryClient(ctx.CosmosConn)
 	coinList, err := oracleClient.Coins(c.Background(), &oracleTypes.QueryCoinsRequest{})
 	if err != nil {
-		panic(err)
+		return ctx, fmt.Errorf("getting hub coins: %w", err)
 	}
 
 	firstBlock := ctx.LastCheckedMinterBlock
@@ -49,12 +61,9 @@ func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) cont
 			to = latestBlock
 		}
 
-		blocks, err := ctx.MinterClient.Blocks(from, to, false)
+		blocks, err := GetBlocks(ctx, ctx.MinterClient, ctx.Logger, from, to)
 		if err != nil {
-			ctx.Logger.Error("Error while getting minter blocks", "err", err.Error())
-			time.Sleep(time.Second)
-			i--
-			continue
+			return ctx, err
 		}
 
 		ctx.Logger.Debug("Scanning blocks", "from", from, "to", to, "nonce", ctx.LastEventNonce)
@@ -82,7 +91,7 @@ func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) cont
 
 								if currentNonce > 0 && currentNonce < ctx.LastEventNonce {
 									ctx.LastCheckedMinterBlock = block.Height - 1
-									return ctx
+									return ctx, nil
 								}
 
 								ctx.LastEventNonce++
@@ -92,19 +101,24 @@ func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) cont
 				}
 
 				if tx.Type == uint64(transaction.TypeMultisend) && tx.From == ctx.MinterMultisigAddr {
-					ctx.Logger.Debug("Found batch")
+					nonce, err := ParseBatchPayload(tx.Payload, ctx.LastBatchNonce)
+					if err != nil {
+						ctx.Logger.Error("Unrecognized multisend from multisig, skipping", "hash", tx.Hash, "err", err.Error())
+					} else {
+						ctx.Logger.Debug("Found batch")
 
-					if currentNonce > 0 && currentNonce < ctx.LastEventNonce {
-						ctx.LastCheckedMinterBlock = block.Height - 1
-						return ctx
-					}
+						if currentNonce > 0 && currentNonce < ctx.LastEventNonce {
+							ctx.LastCheckedMinterBlock = block.Height - 1
+							return ctx, nil
+						}
 
-					ctx.LastEventNonce++
-					ctx.LastBatchNonce++
+						ctx.LastEventNonce++
+						ctx.LastBatchNonce = nonce
+					}
 				}
 
 				if tx.Type == uint64(transaction.TypeEditMultisig) && tx.From == ctx.MinterMultisigAddr {
-					nonce, err := strconv.Atoi(string(tx.Payload))
+					nonce, err := ParseNoncePayload(tx.Payload)
 					if err != nil {
 						ctx.Logger.Error("Error on decoding valset nonce", "err", err.Error())
 					} else {
@@ -112,10 +126,10 @@ func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) cont
 
 						if currentNonce > 0 && currentNonce < ctx.LastEventNonce {
 							ctx.LastCheckedMinterBlock = block.Height - 1
-							return ctx
+							return ctx, nil
 						}
 
-						ctx.LastValsetNonce = uint64(nonce)
+						ctx.LastValsetNonce = nonce
 						ctx.LastEventNonce++
 					}
 				}
@@ -125,5 +139,76 @@ func GetLatestMinterBlockAndNonce(ctx context.Context, currentNonce uint64) cont
 		}
 	}
 
-	return ctx
+	return ctx, nil
+}
+
+// ParseNoncePayload decodes the hub nonce which batch and valset txs carry in the payload
+func ParseNoncePayload(payload []byte) (uint64, error) {
+	nonce, err := strconv.ParseUint(string(payload), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("decoding nonce payload: %w", err)
+	}
+
+	return nonce, nil
+}
+
+// ParseBatchPayload decodes the batch nonce of a multisend from the multisig. Only the batches after the last executed
+// one are accepted, the other multisends are not the hub batches.
+func ParseBatchPayload(payload []byte, lastBatchNonce uint64) (uint64, error) {
+	nonce, err := ParseNoncePayload(payload)
+	if err != nil {
+		return 0, err
+	}
+
+	if nonce <= lastBatchNonce {
+		return 0, fmt.Errorf("batch nonce %d is not after the last executed batch %d", nonce, lastBatchNonce)
+	}
+
+	return nonce, nil
+}
+
+// GetBlocks returns Minter blocks in the given range, retrying failed requests
+func GetBlocks(ctx c.Context, client *http_client.Client, logger log.Logger, from, to uint64) (*models.BlocksResponse, error) {
+	var blocks *models.BlocksResponse
+	err := helpers.DefaultBackoff.Retry(ctx, func() error {
+		var err error
+		blocks, err = client.Blocks(from, to, false)
+		if err != nil {
+			logger.Error("Error while getting minter blocks", "err", err.Error())
+		}
+
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("getting Minter blocks %d-%d: %w", from, to, err)
+	}
+
+	return blocks, nil
+}
+
+// VerifyTxs re-fetches the block at the given height and checks that it still contains all of the given txs
+func VerifyTxs(ctx c.Context, client *http_client.Client, logger log.Logger, height uint64, hashes []string) error {
+	blocks, err := GetBlocks(ctx, client, logger, height, height)
+	if err != nil {
+		return err
+	}
+
+	found := map[string]bool{}
+	for _, block := range blocks.Blocks {
+		if block.Height != height {
+			continue
+		}
+
+		for _, tx := range block.Transactions {
+			found[tx.Hash] = true
+		}
+	}
+
+	for _, hash := range hashes {
+		if !found[hash] {
+			return fmt.Errorf("tx %s is not found in Minter block %d", hash, height)
+		}
+	}
+
+	return nil
 }
@@ -0,0 +1,38 @@
+package minter
+
+import "testing"
+
+func TestParseNoncePayload(t *testing.T) {
+	nonce, err := ParseNoncePayload([]byte("42"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nonce != 42 {
+		t.Fatalf("expected nonce 42, got %d", nonce)
+	}
+
+	for _, payload := range []string{"", "cold storage", "-1"} {
+		if _, err := ParseNoncePayload([]byte(payload)); err == nil {
+			t.Fatalf("expected error for payload %q", payload)
+		}
+	}
+}
+
+func TestParseBatchPayload(t *testing.T) {
+	nonce, err := ParseBatchPayload([]byte("8"), 7)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nonce != 8 {
+		t.Fatalf("expected nonce 8, got %d", nonce)
+	}
+
+	// the already executed batches and the payloads which are not batch nonces are not batches
+	for _, payload := range []string{"7", "3", "cold storage"} {
+		if _, err := ParseBatchPayload([]byte(payload), 7); err == nil {
+			t.Fatalf("expected error for payload %q", payload)
+		}
+	}
+}
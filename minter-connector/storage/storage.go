@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"encoding/json"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+var checkpointKey = []byte("checkpoint")
+
+// Checkpoint is the progress of the connector in Minter blockchain
+type Checkpoint struct {
+	LastCheckedMinterBlock uint64 `json:"last_checked_minter_block"`
+	LastEventNonce         uint64 `json:"last_event_nonce"`
+	LastBatchNonce         uint64 `json:"last_batch_nonce"`
+	LastValsetNonce        uint64 `json:"last_valset_nonce"`
+}
+
+// Store persists connector checkpoints on disk
+type Store struct {
+	db dbm.DB
+}
+
+// New opens (or creates) checkpoint store in the given directory
+func New(dataDir string) (*Store, error) {
+	db, err := dbm.NewGoLevelDB("connector", dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// GetCheckpoint returns the last saved checkpoint or nil if nothing was saved yet
+func (s *Store) GetCheckpoint() (*Checkpoint, error) {
+	bz, err := s.db.Get(checkpointKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if bz == nil {
+		return nil, nil
+	}
+
+	checkpoint := &Checkpoint{}
+	if err := json.Unmarshal(bz, checkpoint); err != nil {
+		return nil, err
+	}
+
+	return checkpoint, nil
+}
+
+// SetCheckpoint saves checkpoint and flushes it to disk
+func (s *Store) SetCheckpoint(checkpoint Checkpoint) error {
+	bz, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	return s.db.SetSync(checkpointKey, bz)
+}
+
+// Close closes the underlying database
+func (s *Store) Close() error {
+	return s.db.Close()
+}
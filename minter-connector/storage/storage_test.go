@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func TestCheckpoint(t *testing.T) {
+	dir, err := ioutil.TempDir("", "connector")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	store, err := New(dir)
+	if err != nil {
+		t.Fatalf("Opening store failed: %s", err.Error())
+	}
+
+	checkpoint, err := store.GetCheckpoint()
+	if err != nil {
+		t.Fatalf("Reading checkpoint failed: %s", err.Error())
+	}
+	if checkpoint != nil {
+		t.Fatalf("Expected empty checkpoint, got %#v", checkpoint)
+	}
+
+	expected := Checkpoint{LastCheckedMinterBlock: 100, LastEventNonce: 5, LastBatchNonce: 2, LastValsetNonce: 3}
+	if err := store.SetCheckpoint(expected); err != nil {
+		t.Fatalf("Saving checkpoint failed: %s", err.Error())
+	}
+	if err := store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	store, err = New(dir)
+	if err != nil {
+		t.Fatalf("Reopening store failed: %s", err.Error())
+	}
+	defer store.Close()
+
+	checkpoint, err = store.GetCheckpoint()
+	if err != nil {
+		t.Fatalf("Reading checkpoint failed: %s", err.Error())
+	}
+	if checkpoint == nil || *checkpoint != expected {
+		t.Fatalf("Expected %#v, got %#v", expected, checkpoint)
+	}
+}
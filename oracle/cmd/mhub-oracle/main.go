@@ -11,20 +11,16 @@ import (
 	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client"
 	"github.com/MinterTeam/minter-hub-oracle/config"
 	"github.com/MinterTeam/minter-hub-oracle/cosmos"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers"
 	"github.com/MinterTeam/minter-hub-oracle/services/ethereum/gasprice"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
-	"github.com/valyala/fasthttp"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 )
 
-const multiplier = 1e10
-const usdteCoinId = 1993
-
-var pipInBip = sdk.NewInt(1000000000000000000)
-
 func main() {
 	logger := log.NewTMLogger(os.Stdout)
 
@@ -57,139 +53,101 @@ func main() {
 		panic(err)
 	}
 
+	priceService, err := coinprice.NewService(cfg, minterClient, logger)
+
+	if err != nil {
+		panic(err)
+	}
+
 	for {
-		relayPrices(minterClient, ethGasPrice, cosmosConn, orcAddress, orcPriv, logger)
+		blocks := relayPrices(priceService, ethGasPrice, cosmosConn, orcAddress, orcPriv, logger)
 
-		time.Sleep(1 * time.Second)
+		time.Sleep(time.Duration(blocks) * cfg.Cosmos.BlockTime)
 	}
 }
 
 func relayPrices(
-	minterClient *http_client.Client,
+	priceService *coinprice.Service,
 	ethGasPrice *gasprice.Service,
 	cosmosConn *grpc.ClientConn,
 	orcAddress sdk.AccAddress,
 	orcPriv *secp256k1.PrivKey,
 	logger log.Logger,
-) {
+) (blocksToWait int64) {
 	cosmosClient := types.NewQueryClient(cosmosConn)
 
 	response, err := cosmosClient.CurrentEpoch(context.Background(), &types.QueryCurrentEpochRequest{})
 	if err != nil {
 		logger.Error("Error getting current epoch", "err", err.Error())
-		time.Sleep(time.Second)
-		return
+		return 1
+	}
+
+	// the next epoch starts right after the end of the current one
+	nextEpochIn := response.GetEndHeight() - response.GetHeight() + 1
+	if nextEpochIn < 1 {
+		nextEpochIn = 1
+	}
+
+	if response.GetHeight() > response.GetVotingEndHeight() {
+		return nextEpochIn
 	}
 
 	// check if already voted
 	for _, vote := range response.GetEpoch().GetVotes() {
 		if vote.Oracle == orcAddress.String() {
-			return
+			return nextEpochIn
 		}
 	}
 
 	coins, err := cosmosClient.Coins(context.Background(), &types.QueryCoinsRequest{})
 	if err != nil {
 		logger.Error("Error getting coins list", "err", err.Error())
-		time.Sleep(time.Second)
-		return
+		return 1
 	}
 
 	prices := &types.Prices{List: []*types.Price{}}
 
-	basecoinPrice := getBasecoinPrice(logger, minterClient)
-	ethPrice := getEthPrice(logger)
-
 	for _, coin := range coins.GetCoins() {
-		if coin.MinterId == 0 {
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: basecoinPrice,
-			})
-
+		price, err := priceService.GetPrice(providers.Coin{Denom: coin.Denom, MinterID: uint64(coin.MinterId)})
+		if err != nil {
+			logger.Error("Skipping coin price", "coin", coin.Denom, "err", err.Error())
 			continue
 		}
 
-		switch coin.Denom {
-		case "usdt", "usdc", "busd", "dai", "ust", "pax", "tusd", "husd":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: sdk.NewInt(10000000000),
-			})
-		case "wbtc":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: getBitcoinPrice(logger),
-			})
-		case "weth":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: ethPrice,
-			})
-		case "bnb":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: getBnbPrice(logger),
-			})
-		case "oneinch":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: get1inchPrice(logger),
-			})
-		case "ton":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: getTonPrice(logger),
-			})
-		case "shib":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: getShibPrice(logger),
-			})
-		case "mvi":
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: getMetaversePrice(logger),
-			})
-		default:
-			var route []uint64
-			if coin.Denom == "hubabuba" {
-				const hubCoinId = 1902
-				route = []uint64{hubCoinId}
-			}
-
-			response, err := minterClient.EstimateCoinIDSellExtended(0, uint64(coin.MinterId), pipInBip.String(), 0, "pool", route)
-			if err != nil {
-				_, payload, err := http_client.ErrorBody(err)
-				if err != nil {
-					logger.Error("Error estimating coin sell", "coin", coin.Denom, "err", err.Error())
-				} else {
-					logger.Error("Error estimating coin sell", "coin", coin.Denom, "err", payload.Error.Message)
-				}
-
-				time.Sleep(time.Second)
-				return
-			}
-
-			priceInBasecoin, _ := sdk.NewIntFromString(response.WillGet)
-			price := priceInBasecoin.Mul(basecoinPrice).Quo(pipInBip)
-
-			prices.List = append(prices.List, &types.Price{
-				Name:  fmt.Sprintf("minter/%d", coin.MinterId),
-				Value: price,
-			})
-		}
+		prices.List = append(prices.List, &types.Price{
+			Name:  fmt.Sprintf("minter/%d", coin.MinterId),
+			Value: types.PriceFromDec(price, coin.EffectivePricePrecision()),
+		})
 	}
 
-	prices.List = append(prices.List, &types.Price{
-		Name:  "eth/0",
-		Value: ethPrice,
-	})
+	ethPrice, err := priceService.GetEthPrice()
+	if err != nil {
+		logger.Error("Skipping eth price", "err", err.Error())
+	} else {
+		prices.List = append(prices.List, &types.Price{
+			Name:  types.PriceNameEth,
+			Value: types.PriceFromDec(ethPrice, types.DefaultPricePrecision),
+		})
+	}
 
-	prices.List = append(prices.List, &types.Price{
-		Name:  "eth/gas",
-		Value: ethGasPrice.GetGasPrice().Fast,
-	})
+	gasPrice, err := ethGasPrice.GetGasPrice()
+	if err != nil {
+		logger.Error("Skipping eth gas price", "err", err.Error())
+	} else {
+		prices.List = append(prices.List, &types.Price{
+			Name:  types.PriceNameEthBaseFee,
+			Value: types.PriceFromDec(gasPrice.BaseFee, types.DefaultPricePrecision),
+		}, &types.Price{
+			Name:  types.PriceNameEthPriorityFee,
+			Value: types.PriceFromDec(gasPrice.PriorityFee, types.DefaultPricePrecision),
+		})
+	}
+
+	// the hub accepts a claim with a subset of the prices, but not an empty one
+	if len(prices.List) == 0 {
+		logger.Error("No prices to vote for, retrying in the next block")
+		return 1
+	}
 
 	jsonPrices, _ := json.Marshal(prices.List)
 	logger.Info("Prices", "val", jsonPrices)
@@ -201,145 +159,7 @@ func relayPrices(
 	}
 
 	cosmos.SendCosmosTx([]sdk.Msg{msg}, orcAddress, orcPriv, cosmosConn, logger)
-}
-
-func getBasecoinPrice(logger log.Logger, client *http_client.Client) sdk.Int {
-	response, err := client.EstimateCoinIDSell(usdteCoinId, 0, pipInBip.String(), 0)
-	if err != nil {
-		_, payload, err := http_client.ErrorBody(err)
-		if err != nil {
-			logger.Error("Error estimating coin sell", "coin", "basecoin", "err", err.Error())
-		} else {
-			logger.Error("Error estimating coin sell", "coin", "basecoin", "err", payload.Error.Message)
-		}
-
-		time.Sleep(time.Second)
-
-		return getBasecoinPrice(logger, client)
-	}
-
-	price, _ := sdk.NewIntFromString(response.WillGet)
 
-	return price.Mul(sdk.NewInt(multiplier)).Quo(pipInBip)
+	// the vote is checked once again in the next block in case the tx did not make it
+	return 1
 }
-
-func getEthPrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=ethereum&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting eth price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getEthPrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting eth price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getEthPrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["ethereum"]["usd"] * multiplier)) // todo
-}
-
-func getBitcoinPrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=bitcoin&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting btc price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getEthPrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting btc price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getEthPrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["bitcoin"]["usd"] * multiplier)) // todo
-}
-
-func getBnbPrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=binancecoin&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting bnb price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getBnbPrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting bnb price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getBnbPrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["binancecoin"]["usd"] * multiplier)) // todo
-}
-
-func get1inchPrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=1inch&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting 1inch price", "err", err.Error())
-		time.Sleep(time.Second)
-		return get1inchPrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting 1inch price", "err", err.Error())
-		time.Sleep(time.Second)
-		return get1inchPrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["1inch"]["usd"] * multiplier)) // todo
-}
-
-func getTonPrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=the-open-network&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting TONCOIN price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getTonPrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting TONCOIN price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getTonPrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["the-open-network"]["usd"] * multiplier)) // todo
-}
-
-func getShibPrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=shiba-inu&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting SHIB price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getShibPrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting SHIB price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getShibPrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["shiba-inu"]["usd"] * multiplier)) // todo
-}
-
-func getMetaversePrice(logger log.Logger) sdk.Int {
-	_, body, err := fasthttp.Get(nil, "https://api.coingecko.com/api/v3/simple/price?ids=metaverse-index&vs_currencies=usd")
-	if err != nil {
-		logger.Error("Error getting metaverse-index price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getMetaversePrice(logger)
-	}
-	var result CoingeckoResult
-	if err := json.Unmarshal(body, &result); err != nil {
-		logger.Error("Error getting metaverse-index price", "err", err.Error())
-		time.Sleep(time.Second)
-		return getMetaversePrice(logger)
-	}
-
-	return sdk.NewInt(int64(result["metaverse-index"]["usd"] * multiplier)) // todo
-}
-
-type CoingeckoResult map[string]map[string]float64
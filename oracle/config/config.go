@@ -2,6 +2,7 @@ package config
 
 import (
 	"flag"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -14,16 +15,42 @@ type CosmosConfig struct {
 	Mnemonic string
 	GrpcAddr string `mapstructure:"grpc_addr"`
 	RpcAddr  string `mapstructure:"rpc_addr"`
+
+	// expected interval between the Hub blocks, the votes are scheduled by the epoch heights
+	BlockTime time.Duration `mapstructure:"block_time"`
 }
 
 type EthereumConfig struct {
-	GasPriceProviders []string `mapstructure:"gas_price_providers"`
+	GasPriceProviders    []string `mapstructure:"gas_price_providers"`
+	GasPriceMaxDeviation string   `mapstructure:"gas_price_max_deviation"`
+	GasPriceMinSources   int      `mapstructure:"gas_price_min_sources"`
+
+	// JSON-RPC endpoint of the Ethereum node used by the ethnode gas price provider
+	RpcAddr               string  `mapstructure:"rpc_addr"`
+	FeeHistoryBlocks      uint64  `mapstructure:"fee_history_blocks"`
+	PriorityFeePercentile float64 `mapstructure:"priority_fee_percentile"`
+}
+
+// PriceSource tells which provider gives the price of a coin and with which parameters
+type PriceSource struct {
+	Provider string
+	ID       string
+	Route    []uint64
+	Value    string
+}
+
+type PricesConfig struct {
+	Eth          []PriceSource
+	Coins        map[string][]PriceSource
+	MaxDeviation string `mapstructure:"max_deviation"`
+	MinSources   int    `mapstructure:"min_sources"`
 }
 
 type Config struct {
 	Cosmos   CosmosConfig
 	Minter   MinterConfig
 	Ethereum EthereumConfig
+	Prices   PricesConfig
 }
 
 func Get() *Config {
@@ -34,6 +61,13 @@ func Get() *Config {
 
 	v := viper.New()
 	v.SetConfigFile(*configPath)
+	v.SetDefault("cosmos.block_time", "1s")
+	v.SetDefault("ethereum.gas_price_max_deviation", "25")
+	v.SetDefault("ethereum.gas_price_min_sources", 1)
+	v.SetDefault("ethereum.fee_history_blocks", 20)
+	v.SetDefault("ethereum.priority_fee_percentile", 60)
+	v.SetDefault("prices.max_deviation", "5")
+	v.SetDefault("prices.min_sources", 1)
 
 	if err := v.ReadInConfig(); err != nil {
 		panic(err)
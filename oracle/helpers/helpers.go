@@ -1 +1,77 @@
 package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ErrNoQuorum is returned when there are not enough sources agreeing on a value
+var ErrNoQuorum = errors.New("not enough agreeing sources")
+
+// Aggregation combines the values reported by several sources into one
+type Aggregation struct {
+	// MaxDeviation is the allowed relative deviation from the median, 0.05 stands for 5%
+	MaxDeviation sdk.Dec
+	// MinSources is the number of sources which have to agree on the value
+	MinSources int
+}
+
+// Aggregate returns the median of the values which do not deviate from the median of all values by more than
+// MaxDeviation. ErrNoQuorum is returned if less than MinSources values are left.
+func (a Aggregation) Aggregate(values []sdk.Dec) (sdk.Dec, error) {
+	if len(values) == 0 {
+		return sdk.Dec{}, fmt.Errorf("%w: no values", ErrNoQuorum)
+	}
+
+	median := Median(values)
+	maxDiff := a.MaxDeviation.Mul(median)
+
+	var agreeing []sdk.Dec
+	for _, value := range values {
+		if value.GTE(median.Sub(maxDiff)) && value.LTE(median.Add(maxDiff)) {
+			agreeing = append(agreeing, value)
+		}
+	}
+
+	if len(agreeing) < a.MinSources {
+		return sdk.Dec{}, fmt.Errorf("%w: %d of %d, need %d", ErrNoQuorum, len(agreeing), len(values), a.MinSources)
+	}
+
+	return Median(agreeing), nil
+}
+
+// Median returns the median of the values. Values must not be empty.
+func Median(values []sdk.Dec) sdk.Dec {
+	sorted := make([]sdk.Dec, len(values))
+	copy(sorted, values)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	if len(sorted)%2 == 0 {
+		return sorted[len(sorted)/2].Add(sorted[len(sorted)/2-1]).QuoInt64(2)
+	}
+
+	return sorted[len(sorted)/2]
+}
+
+// DecFromJSONNumber converts a JSON number, including the ones in exponent notation, into an exact decimal.
+// Digits beyond the decimal precision are truncated.
+func DecFromJSONNumber(number json.Number) (sdk.Dec, error) {
+	rat, ok := new(big.Rat).SetString(number.String())
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid number: %s", number)
+	}
+
+	precisionMultiplier := new(big.Int).Exp(big.NewInt(10), big.NewInt(sdk.Precision), nil)
+	scaled := new(big.Int).Mul(rat.Num(), precisionMultiplier)
+	scaled.Quo(scaled, rat.Denom())
+
+	return sdk.NewDecFromBigIntWithPrec(scaled, sdk.Precision), nil
+}
@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestAggregate(t *testing.T) {
+	aggregation := Aggregation{
+		MaxDeviation: sdk.NewDecWithPrec(5, 2),
+		MinSources:   2,
+	}
+
+	decs := func(values ...int64) []sdk.Dec {
+		var out []sdk.Dec
+		for _, v := range values {
+			out = append(out, sdk.NewDec(v))
+		}
+
+		return out
+	}
+
+	specs := map[string]struct {
+		values []sdk.Dec
+		exp    sdk.Dec
+		expErr bool
+	}{
+		"median": {
+			values: decs(100, 102, 98),
+			exp:    sdk.NewDec(100),
+		},
+		"outlier is discarded": {
+			values: decs(100, 102, 98, 1000),
+			exp:    sdk.NewDec(100),
+		},
+		"even number of values": {
+			values: decs(100, 102),
+			exp:    sdk.NewDec(101),
+		},
+		"no quorum": {
+			values: decs(100, 200),
+			expErr: true,
+		},
+		"single source": {
+			values: decs(100),
+			expErr: true,
+		},
+		"empty": {
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			value, err := aggregation.Aggregate(spec.values)
+			if spec.expErr {
+				if !errors.Is(err, ErrNoQuorum) {
+					t.Fatalf("expected ErrNoQuorum, got %v", err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !value.Equal(spec.exp) {
+				t.Fatalf("expected %s, got %s", spec.exp, value)
+			}
+		})
+	}
+}
+
+func TestDecFromJSONNumber(t *testing.T) {
+	specs := map[string]struct {
+		number json.Number
+		exp    string
+		expErr bool
+	}{
+		"integer": {
+			number: "3000",
+			exp:    "3000.000000000000000000",
+		},
+		"fraction": {
+			number: "0.1",
+			exp:    "0.100000000000000000",
+		},
+		"exponent": {
+			number: "1.23e-7",
+			exp:    "0.000000123000000000",
+		},
+		"beyond precision is truncated": {
+			number: "1e-19",
+			exp:    "0.000000000000000000",
+		},
+		"invalid": {
+			number: "abc",
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			value, err := DecFromJSONNumber(spec.number)
+			if spec.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if value.String() != spec.exp {
+				t.Fatalf("expected %s, got %s", spec.exp, value)
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+package coinprice
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client"
+	"github.com/MinterTeam/minter-hub-oracle/config"
+	"github.com/MinterTeam/minter-hub-oracle/helpers"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers/coingecko"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers/fixed"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers/minter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+type Service struct {
+	eth         []providers.Provider
+	coins       map[string][]providers.Provider
+	fallback    []providers.Provider
+	aggregation helpers.Aggregation
+	logger      log.Logger
+}
+
+func NewService(cfg *config.Config, minterClient *http_client.Client, logger log.Logger) (*Service, error) {
+	maxDeviation, err := sdk.NewDecFromStr(cfg.Prices.MaxDeviation)
+	if err != nil {
+		return nil, fmt.Errorf("max deviation: %w", err)
+	}
+
+	eth, err := newProviders(cfg.Prices.Eth, minterClient)
+	if err != nil {
+		return nil, fmt.Errorf("eth: %w", err)
+	}
+
+	coins := map[string][]providers.Provider{}
+	for denom, sources := range cfg.Prices.Coins {
+		pp, err := newProviders(sources, minterClient)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", denom, err)
+		}
+
+		coins[denom] = pp
+	}
+
+	return &Service{
+		eth:      eth,
+		coins:    coins,
+		fallback: []providers.Provider{minter.New(minterClient, nil)},
+		aggregation: helpers.Aggregation{
+			MaxDeviation: maxDeviation.QuoInt64(100),
+			MinSources:   cfg.Prices.MinSources,
+		},
+		logger: logger,
+	}, nil
+}
+
+func newProviders(sources []config.PriceSource, minterClient *http_client.Client) ([]providers.Provider, error) {
+	if len(sources) == 0 {
+		return nil, errors.New("define at least 1 price source")
+	}
+
+	var pp []providers.Provider
+	for _, source := range sources {
+		p, err := newProvider(source, minterClient)
+		if err != nil {
+			return nil, err
+		}
+
+		pp = append(pp, p)
+	}
+
+	return pp, nil
+}
+
+func newProvider(source config.PriceSource, minterClient *http_client.Client) (providers.Provider, error) {
+	switch source.Provider {
+	case "coingecko":
+		if source.ID == "" {
+			return nil, errors.New("define coingecko id")
+		}
+
+		return coingecko.New(source.ID), nil
+	case "minter":
+		return minter.New(minterClient, source.Route), nil
+	case "fixed":
+		return fixed.New(source.Value)
+	default:
+		return nil, fmt.Errorf("unknown price provider: %s", source.Provider)
+	}
+}
+
+// GetPrice returns the USD price of the hub coin aggregated from the sources configured for it
+func (s *Service) GetPrice(coin providers.Coin) (sdk.Dec, error) {
+	pp, ok := s.coins[coin.Denom]
+	if !ok {
+		pp = s.fallback
+	}
+
+	return s.aggregate(pp, coin)
+}
+
+// GetEthPrice returns the USD price of ether aggregated from the configured sources
+func (s *Service) GetEthPrice() (sdk.Dec, error) {
+	return s.aggregate(s.eth, providers.Coin{Denom: "eth"})
+}
+
+func (s *Service) aggregate(pp []providers.Provider, coin providers.Coin) (sdk.Dec, error) {
+	var prices []sdk.Dec
+	for _, p := range pp {
+		price, err := p.GetPrice(coin)
+		if err != nil {
+			s.logger.Error(
+				fmt.Sprintf("Error getting %s price from %s", coin.Denom, p.Name()),
+				"err",
+				err.Error(),
+			)
+
+			continue
+		}
+
+		prices = append(prices, price)
+	}
+
+	price, err := s.aggregation.Aggregate(prices)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("aggregating %s price: %w", coin.Denom, err)
+	}
+
+	return price, nil
+}
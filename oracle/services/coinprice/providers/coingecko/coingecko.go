@@ -0,0 +1,49 @@
+package coingecko
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/MinterTeam/minter-hub-oracle/helpers"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/valyala/fasthttp"
+)
+
+type result map[string]map[string]json.Number
+
+type Provider struct {
+	id string
+}
+
+// New returns Provider of the price of the coin with the given CoinGecko id
+func New(id string) *Provider {
+	return &Provider{id: id}
+}
+
+func (p Provider) Name() string {
+	return "coingecko"
+}
+
+func (p *Provider) GetPrice(_ providers.Coin) (sdk.Dec, error) {
+	_, body, err := fasthttp.Get(nil, fmt.Sprintf("https://api.coingecko.com/api/v3/simple/price?ids=%s&vs_currencies=usd", p.id))
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+
+	var result result
+	if err := decoder.Decode(&result); err != nil {
+		return sdk.Dec{}, err
+	}
+
+	price, ok := result[p.id]["usd"]
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("no usd price of %s", p.id)
+	}
+
+	return helpers.DecFromJSONNumber(price)
+}
@@ -0,0 +1,30 @@
+package fixed
+
+import (
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Provider struct {
+	price sdk.Dec
+}
+
+// New returns Provider of the price pegged to the given USD value
+func New(value string) (*Provider, error) {
+	price, err := sdk.NewDecFromStr(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Provider{
+		price: price,
+	}, nil
+}
+
+func (p Provider) Name() string {
+	return "fixed"
+}
+
+func (p *Provider) GetPrice(_ providers.Coin) (sdk.Dec, error) {
+	return p.price, nil
+}
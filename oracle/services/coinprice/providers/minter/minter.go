@@ -0,0 +1,77 @@
+package minter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/MinterTeam/minter-go-sdk/v2/api/http_client"
+	"github.com/MinterTeam/minter-hub-oracle/services/coinprice/providers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const usdteCoinId = 1993
+
+var pipInBip = sdk.NewInt(1000000000000000000)
+
+type Provider struct {
+	client *http_client.Client
+	route  []uint64
+}
+
+// New returns Provider which estimates the price by selling the coin in Minter pools through the given route
+func New(client *http_client.Client, route []uint64) *Provider {
+	return &Provider{
+		client: client,
+		route:  route,
+	}
+}
+
+func (p Provider) Name() string {
+	return "minter"
+}
+
+func (p *Provider) GetPrice(coin providers.Coin) (sdk.Dec, error) {
+	basecoinPrice, err := p.getBasecoinPrice()
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if coin.MinterID == 0 {
+		return basecoinPrice, nil
+	}
+
+	response, err := p.client.EstimateCoinIDSellExtended(0, coin.MinterID, pipInBip.String(), 0, "pool", p.route)
+	if err != nil {
+		return sdk.Dec{}, estimateError(err)
+	}
+
+	priceInBasecoin, ok := sdk.NewIntFromString(response.WillGet)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid estimate: %s", response.WillGet)
+	}
+
+	return basecoinPrice.MulInt(priceInBasecoin).QuoInt(pipInBip), nil
+}
+
+func (p *Provider) getBasecoinPrice() (sdk.Dec, error) {
+	response, err := p.client.EstimateCoinIDSell(usdteCoinId, 0, pipInBip.String(), 0)
+	if err != nil {
+		return sdk.Dec{}, estimateError(err)
+	}
+
+	price, ok := sdk.NewIntFromString(response.WillGet)
+	if !ok {
+		return sdk.Dec{}, fmt.Errorf("invalid estimate: %s", response.WillGet)
+	}
+
+	return price.ToDec().QuoInt(pipInBip), nil
+}
+
+func estimateError(err error) error {
+	_, payload, bodyErr := http_client.ErrorBody(err)
+	if bodyErr != nil {
+		return err
+	}
+
+	return errors.New(payload.Error.Message)
+}
@@ -0,0 +1,17 @@
+package providers
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Provider interface {
+	Name() string
+	// GetPrice returns the USD price of the coin
+	GetPrice(coin Coin) (sdk.Dec, error)
+}
+
+// Coin is the hub coin which price is requested
+type Coin struct {
+	Denom    string
+	MinterID uint64
+}
@@ -3,18 +3,21 @@ package gasprice
 import (
 	"errors"
 	"fmt"
-	"time"
 
 	"github.com/MinterTeam/minter-hub-oracle/config"
+	"github.com/MinterTeam/minter-hub-oracle/helpers"
 	"github.com/MinterTeam/minter-hub-oracle/services/ethereum/gasprice/providers"
 	"github.com/MinterTeam/minter-hub-oracle/services/ethereum/gasprice/providers/etherchain"
 	"github.com/MinterTeam/minter-hub-oracle/services/ethereum/gasprice/providers/ethgasstation"
+	"github.com/MinterTeam/minter-hub-oracle/services/ethereum/gasprice/providers/ethnode"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
 type Service struct {
-	providers []providers.Provider
-	logger    log.Logger
+	providers   []providers.Provider
+	aggregation helpers.Aggregation
+	logger      log.Logger
 }
 
 func NewService(cfg *config.Config, logger log.Logger) (*Service, error) {
@@ -22,6 +25,11 @@ func NewService(cfg *config.Config, logger log.Logger) (*Service, error) {
 		return nil, errors.New("define at least 1 ethereum gas price provider")
 	}
 
+	maxDeviation, err := sdk.NewDecFromStr(cfg.Ethereum.GasPriceMaxDeviation)
+	if err != nil {
+		return nil, fmt.Errorf("gas price max deviation: %w", err)
+	}
+
 	var pp []providers.Provider
 
 	for _, name := range cfg.Ethereum.GasPriceProviders {
@@ -34,6 +42,13 @@ func NewService(cfg *config.Config, logger log.Logger) (*Service, error) {
 		case "etherchain":
 			p = etherchain.New()
 			break
+		case "ethnode":
+			if cfg.Ethereum.RpcAddr == "" {
+				return nil, errors.New("ethnode gas price provider requires ethereum rpc_addr")
+			}
+
+			p = ethnode.New(cfg.Ethereum.RpcAddr, cfg.Ethereum.FeeHistoryBlocks, cfg.Ethereum.PriorityFeePercentile)
+			break
 		default:
 			return nil, errors.New(fmt.Sprintf(
 				"unknown eth gas price provider: %s",
@@ -46,11 +61,17 @@ func NewService(cfg *config.Config, logger log.Logger) (*Service, error) {
 
 	return &Service{
 		providers: pp,
-		logger:    logger,
+		aggregation: helpers.Aggregation{
+			MaxDeviation: maxDeviation.QuoInt64(100),
+			MinSources:   cfg.Ethereum.GasPriceMinSources,
+		},
+		logger: logger,
 	}, nil
 }
 
-func (s *Service) GetGasPrice() providers.GasPrice {
+// GetGasPrice returns the gas price aggregated from all providers
+func (s *Service) GetGasPrice() (*providers.GasPrice, error) {
+	var baseFees, priorityFees []sdk.Dec
 	for _, p := range s.providers {
 		res, err := p.GetGasPrice()
 
@@ -64,11 +85,23 @@ func (s *Service) GetGasPrice() providers.GasPrice {
 			continue
 		}
 
-		return *res
+		baseFees = append(baseFees, res.BaseFee)
+		priorityFees = append(priorityFees, res.PriorityFee)
 	}
 
-	s.logger.Error("Failed to get eth gas price using all providers", "err")
-	time.Sleep(time.Second)
+	baseFee, err := s.aggregation.Aggregate(baseFees)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating eth base fee: %w", err)
+	}
+
+	// priority fees are small and vary a lot, so the median is taken without discarding outliers
+	priorityFee := sdk.ZeroDec()
+	if len(priorityFees) != 0 {
+		priorityFee = helpers.Median(priorityFees)
+	}
 
-	return s.GetGasPrice()
+	return &providers.GasPrice{
+		BaseFee:     baseFee,
+		PriorityFee: priorityFee,
+	}, nil
 }
@@ -37,6 +37,7 @@ func (p *Provider) GetGasPrice() (*providers.GasPrice, error) {
 	}
 
 	return &providers.GasPrice{
-		Fast: sdk.NewInt(result.Fast).MulRaw(10),
+		BaseFee:     sdk.NewDec(result.Fast),
+		PriorityFee: sdk.ZeroDec(),
 	}, nil
 }
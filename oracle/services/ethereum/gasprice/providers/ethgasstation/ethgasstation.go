@@ -36,7 +36,9 @@ func (p *Provider) GetGasPrice() (*providers.GasPrice, error) {
 		return nil, err
 	}
 
+	// ethgasstation prices are in tenths of gwei
 	return &providers.GasPrice{
-		Fast: sdk.NewInt(result.Fast),
+		BaseFee:     sdk.NewDecWithPrec(result.Fast, 1),
+		PriorityFee: sdk.ZeroDec(),
 	}, nil
 }
@@ -0,0 +1,186 @@
+package ethnode
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/MinterTeam/minter-hub-oracle/helpers"
+	"github.com/MinterTeam/minter-hub-oracle/services/ethereum/gasprice/providers"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/valyala/fasthttp"
+)
+
+const requestTimeout = 10 * time.Second
+
+var weiInGwei = sdk.NewInt(1e9)
+
+type request struct {
+	JsonRpc string        `json:"jsonrpc"`
+	Id      int           `json:"id"`
+	Method  string        `json:"method"`
+	Params  []interface{} `json:"params"`
+}
+
+type response struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type feeHistory struct {
+	BaseFeePerGas []string   `json:"baseFeePerGas"`
+	Reward        [][]string `json:"reward"`
+}
+
+// Provider takes the gas price from the fee history of an Ethereum node: the base fee of the next block and the median
+// of the priority fees paid at the given percentile in the recent blocks. Nodes without EIP-1559 support are asked
+// for eth_gasPrice instead.
+type Provider struct {
+	addr       string
+	blocks     uint64
+	percentile float64
+}
+
+func New(addr string, blocks uint64, percentile float64) *Provider {
+	return &Provider{
+		addr:       addr,
+		blocks:     blocks,
+		percentile: percentile,
+	}
+}
+
+func (p Provider) Name() string {
+	return "ethnode"
+}
+
+func (p *Provider) GetGasPrice() (*providers.GasPrice, error) {
+	price, err := p.getFeeHistoryPrice()
+	if err != nil {
+		return p.getLegacyPrice()
+	}
+
+	return price, nil
+}
+
+func (p *Provider) getFeeHistoryPrice() (*providers.GasPrice, error) {
+	var history feeHistory
+	if err := p.call("eth_feeHistory", &history, fmt.Sprintf("0x%x", p.blocks), "latest", []float64{p.percentile}); err != nil {
+		return nil, err
+	}
+
+	if len(history.BaseFeePerGas) == 0 {
+		return nil, errors.New("empty fee history")
+	}
+
+	// the last base fee is the one of the next block
+	baseFee, err := parseQuantity(history.BaseFeePerGas[len(history.BaseFeePerGas)-1])
+	if err != nil {
+		return nil, fmt.Errorf("base fee: %w", err)
+	}
+
+	var rewards []sdk.Dec
+	for _, reward := range history.Reward {
+		if len(reward) == 0 {
+			continue
+		}
+
+		value, err := parseQuantity(reward[0])
+		if err != nil {
+			return nil, fmt.Errorf("reward: %w", err)
+		}
+
+		rewards = append(rewards, value)
+	}
+
+	priorityFee := sdk.ZeroDec()
+	if len(rewards) != 0 {
+		priorityFee = helpers.Median(rewards)
+	}
+
+	return &providers.GasPrice{
+		BaseFee:     baseFee,
+		PriorityFee: priorityFee,
+	}, nil
+}
+
+func (p *Provider) getLegacyPrice() (*providers.GasPrice, error) {
+	var gasPrice string
+	if err := p.call("eth_gasPrice", &gasPrice); err != nil {
+		return nil, err
+	}
+
+	price, err := parseQuantity(gasPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	return &providers.GasPrice{
+		BaseFee:     price,
+		PriorityFee: sdk.ZeroDec(),
+	}, nil
+}
+
+func (p *Provider) call(method string, result interface{}, params ...interface{}) error {
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	body, err := json.Marshal(request{
+		JsonRpc: "2.0",
+		Id:      1,
+		Method:  method,
+		Params:  params,
+	})
+	if err != nil {
+		return err
+	}
+
+	req := fasthttp.AcquireRequest()
+	defer fasthttp.ReleaseRequest(req)
+	resp := fasthttp.AcquireResponse()
+	defer fasthttp.ReleaseResponse(resp)
+
+	req.SetRequestURI(p.addr)
+	req.Header.SetMethod(fasthttp.MethodPost)
+	req.Header.SetContentType("application/json")
+	req.SetBody(body)
+
+	if err := fasthttp.DoTimeout(req, resp, requestTimeout); err != nil {
+		return err
+	}
+
+	if resp.StatusCode() != fasthttp.StatusOK {
+		return fmt.Errorf("%s: unexpected status %d", method, resp.StatusCode())
+	}
+
+	var res response
+	if err := json.Unmarshal(resp.Body(), &res); err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+
+	if res.Error != nil {
+		return fmt.Errorf("%s: %s (%d)", method, res.Error.Message, res.Error.Code)
+	}
+
+	if err := json.Unmarshal(res.Result, result); err != nil {
+		return fmt.Errorf("%s: %w", method, err)
+	}
+
+	return nil
+}
+
+// parseQuantity converts a hex encoded amount of wei into gwei
+func parseQuantity(quantity string) (sdk.Dec, error) {
+	value, ok := new(big.Int).SetString(strings.TrimPrefix(quantity, "0x"), 16)
+	if !ok || !strings.HasPrefix(quantity, "0x") {
+		return sdk.Dec{}, fmt.Errorf("invalid quantity: %s", quantity)
+	}
+
+	return sdk.NewIntFromBigInt(value).ToDec().QuoInt(weiInGwei), nil
+}
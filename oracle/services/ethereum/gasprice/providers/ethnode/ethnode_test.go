@@ -0,0 +1,95 @@
+package ethnode
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func newNode(t *testing.T, results map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+
+		result, ok := results[req.Method]
+		if !ok {
+			_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+	}))
+}
+
+func TestGetGasPrice(t *testing.T) {
+	specs := map[string]struct {
+		results     map[string]string
+		expBase     sdk.Dec
+		expPriority sdk.Dec
+		expErr      bool
+	}{
+		"fee history": {
+			results: map[string]string{
+				// base fee of the next block is 30 gwei, median priority fee is 2 gwei
+				"eth_feeHistory": `{"oldestBlock":"0x1","baseFeePerGas":["0x5d21dba00","0x6fc23ac00"],"reward":[["0x3b9aca00"],["0x77359400"],["0xb2d05e00"]]}`,
+				"eth_gasPrice":   `"0x2540be400"`,
+			},
+			expBase:     sdk.NewDec(30),
+			expPriority: sdk.NewDec(2),
+		},
+		"fee history without rewards": {
+			results: map[string]string{
+				"eth_feeHistory": `{"oldestBlock":"0x1","baseFeePerGas":["0x6fc23ac00"],"reward":[]}`,
+			},
+			expBase:     sdk.NewDec(30),
+			expPriority: sdk.ZeroDec(),
+		},
+		"legacy node": {
+			results: map[string]string{
+				"eth_gasPrice": `"0x2540be400"`,
+			},
+			expBase:     sdk.NewDec(10),
+			expPriority: sdk.ZeroDec(),
+		},
+		"invalid quantity": {
+			results: map[string]string{
+				"eth_gasPrice": `"100"`,
+			},
+			expErr: true,
+		},
+		"no methods": {
+			expErr: true,
+		},
+	}
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			node := newNode(t, spec.results)
+			defer node.Close()
+
+			price, err := New(node.URL, 3, 60).GetGasPrice()
+			if spec.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !price.BaseFee.Equal(spec.expBase) {
+				t.Fatalf("expected base fee %s, got %s", spec.expBase, price.BaseFee)
+			}
+
+			if !price.PriorityFee.Equal(spec.expPriority) {
+				t.Fatalf("expected priority fee %s, got %s", spec.expPriority, price.PriorityFee)
+			}
+		})
+	}
+}
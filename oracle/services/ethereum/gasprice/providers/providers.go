@@ -9,6 +9,9 @@ type Provider interface {
 	GetGasPrice() (*GasPrice, error)
 }
 
+// GasPrice is the Ethereum gas price in gwei. Providers without EIP-1559 support report the whole legacy gas price as
+// the base fee.
 type GasPrice struct {
-	Fast sdk.Int
+	BaseFee     sdk.Dec
+	PriorityFee sdk.Dec
 }